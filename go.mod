@@ -2,4 +2,11 @@ module github.com/GIT_USER_ID/GIT_REPO_ID
 
 go 1.18
 
-require github.com/gorilla/mux v1.8.1
+require (
+	github.com/gorilla/mux v1.8.1
+	go.etcd.io/bbolt v1.3.9
+	golang.org/x/crypto v0.5.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require golang.org/x/sys v0.4.0 // indirect
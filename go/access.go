@@ -0,0 +1,390 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+
+	"github.com/gorilla/mux"
+)
+
+//go:embed access_policy_default.json
+var defaultAccessPolicyJSON []byte
+
+// Roles of the default access policy. Roles are granted by the claims of a token or the configuration of an API key.
+const (
+	RoleAdmin    = "admin"
+	RoleEmployee = "employee"
+	RoleCustomer = "customer"
+	RoleService  = "service"
+	// RoleAnonymous grants access to every caller, authenticated or not
+	RoleAnonymous = "anonymous"
+)
+
+// AccessScope restricts a rule to the resources related to the caller
+type AccessScope string
+
+// List of AccessScope
+const (
+	// AccessScopeOwn grants access to the resources of the customer the caller acts as
+	AccessScopeOwn AccessScope = "own"
+	// AccessScopeAssigned grants access to the resources of the customers the employee the caller acts as advises
+	AccessScopeAssigned AccessScope = "assigned"
+	// AccessScopeSelf grants access to the resources of the employee the caller acts as
+	AccessScopeSelf AccessScope = "self"
+)
+
+// employeeIdMe stands for the employee the caller acts as in the path of GetEmployeeCustomers, so that employees
+// list their own customers with GET /v1/employees/me/customers
+const employeeIdMe = "me"
+
+// resolveEmployeeId returns the employee the caller acts as for employeeIdMe, or employeeId as it is. It reports
+// false if the caller does not act as an employee.
+func resolveEmployeeId(ctx context.Context, employeeId string) (string, bool) {
+	if employeeId != employeeIdMe {
+		return employeeId, true
+	}
+	principal, _ := PrincipalFrom(ctx)
+
+	return principal.EmployeeId, principal.EmployeeId != ""
+}
+
+// AccessRule grants a role access to a route, optionally restricted to a scope
+type AccessRule struct {
+	Role  string      `json:"role"`
+	Scope AccessScope `json:"scope,omitempty"`
+}
+
+// AccessPolicy lists the rules granting access to each route by its name, e.g. GetCustomer. The rules of
+// the route "*" apply to every route. Routes without rules cannot be called.
+type AccessPolicy struct {
+	Routes map[string][]AccessRule `json:"routes"`
+}
+
+// ParseAccessPolicy decodes and validates an access policy in its JSON representation
+func ParseAccessPolicy(data []byte) (AccessPolicy, error) {
+	policy := AccessPolicy{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&policy); err != nil {
+		return AccessPolicy{}, err
+	}
+	if err := policy.Validate(); err != nil {
+		return AccessPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// DefaultAccessPolicy returns the access policy shipped with the server
+func DefaultAccessPolicy() (AccessPolicy, error) {
+	policy, err := ParseAccessPolicy(defaultAccessPolicyJSON)
+	if err != nil {
+		return AccessPolicy{}, fmt.Errorf("invalid default access policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// LoadAccessPolicy reads an access policy from a JSON file
+func LoadAccessPolicy(path string) (AccessPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+	policy, err := ParseAccessPolicy(data)
+	if err != nil {
+		return AccessPolicy{}, fmt.Errorf("access policy %s: %w", path, err)
+	}
+
+	return policy, nil
+}
+
+// Validate checks that every rule names a role and a known scope. Anonymous access cannot be scoped.
+func (p AccessPolicy) Validate() error {
+	for name, rules := range p.Routes {
+		for _, rule := range rules {
+			if rule.Role == "" {
+				return fmt.Errorf("a rule of route %s has no role", name)
+			}
+			switch rule.Scope {
+			case "", AccessScopeOwn, AccessScopeAssigned, AccessScopeSelf:
+			default:
+				return fmt.Errorf("a rule of route %s has the unknown scope %q", name, rule.Scope)
+			}
+			if rule.Role == RoleAnonymous && rule.Scope != "" {
+				return fmt.Errorf("anonymous access to route %s cannot be scoped", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// AnonymousRoutes returns the names of the routes granting access to anonymous callers
+func (p AccessPolicy) AnonymousRoutes() []string {
+	names := make([]string, 0)
+	for name, rules := range p.Routes {
+		for _, rule := range rules {
+			if rule.Role == RoleAnonymous {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// rules returns the rules of a route including those applying to every route
+func (p AccessPolicy) rules(name string) []AccessRule {
+	return append(append([]AccessRule{}, p.Routes["*"]...), p.Routes[name]...)
+}
+
+type accessScopeKey struct{}
+
+// AccessScopeFrom returns the scope a request to a collection, such as GetCustomers, was granted in. Servicers
+// only return the resources of the scope, see visibleCustomer. It is empty if the request is not restricted.
+func AccessScopeFrom(ctx context.Context) AccessScope {
+	scope, _ := ctx.Value(accessScopeKey{}).(AccessScope)
+	return scope
+}
+
+// Authorizer grants the principals authenticated by the Authenticator access to the routes according to
+// an AccessPolicy. Requests that are not granted are rejected with 403 Forbidden.
+type Authorizer struct {
+	policy AccessPolicy
+	repo   Repository
+
+	errorHandler ErrorHandler
+}
+
+// AuthorizerOption for how the authorizer is set up.
+type AuthorizerOption func(*Authorizer)
+
+// WithAuthorizerErrorHandler inject ErrorHandler into authorizer
+func WithAuthorizerErrorHandler(h ErrorHandler) AuthorizerOption {
+	return func(a *Authorizer) {
+		a.errorHandler = h
+	}
+}
+
+// NewAuthorizer creates an authorizer evaluating policy. Scoped rules look up the customer of the resource in repo.
+func NewAuthorizer(policy AccessPolicy, repo Repository, opts ...AuthorizerOption) *Authorizer {
+	authorizer := &Authorizer{
+		policy:       policy,
+		repo:         repo,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(authorizer)
+	}
+
+	return authorizer
+}
+
+// Authorize wraps the handler of the named route, rejecting the requests the policy does not grant
+func (a *Authorizer) Authorize(inner http.Handler, name string) http.Handler {
+	rules := a.policy.rules(name)
+	if len(rules) == 0 {
+		log.Printf("Route %s has no access rules and cannot be called", name)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scope, err := a.authorize(r, rules)
+		if errors.Is(err, errForbidden) {
+			a.errorHandler(w, r, fmt.Errorf("%s is not allowed to call %s", callerName(r.Context()), name), &ImplResponse{Code: http.StatusForbidden})
+			return
+		}
+		if err != nil {
+			a.errorHandler(w, r, err, &ImplResponse{Code: http.StatusInternalServerError})
+			return
+		}
+		if scope != "" {
+			r = r.WithContext(context.WithValue(r.Context(), accessScopeKey{}, scope))
+		}
+
+		inner.ServeHTTP(w, r)
+	})
+}
+
+// errForbidden is returned by authorize if no rule grants access
+var errForbidden = errors.New("forbidden")
+
+// authorize evaluates the rules for a request. A rule without scope grants access right away. A scoped rule
+// grants access to a single resource if it is related to the caller; for a collection, a route without path
+// variables, it grants access in its scope, and the servicer filters the collection.
+func (a *Authorizer) authorize(r *http.Request, rules []AccessRule) (AccessScope, error) {
+	principal, authenticated := PrincipalFrom(r.Context())
+	vars := mux.Vars(r)
+
+	// Tokens issued before an employee was deactivated stay valid until they expire, but grant nothing
+	if authenticated && principal.EmployeeId != "" {
+		employee, err := a.repo.GetEmployee(r.Context(), principal.EmployeeId)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return "", err
+		}
+		if err == nil && employee.Status == EmployeeStatusInactive {
+			authenticated = false
+		}
+	}
+	// Tokens issued for an account before its password was reset grant nothing either
+	if authenticated && principal.Method == AuthMethodBearer {
+		account, err := a.repo.GetAccount(r.Context(), principal.Subject)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return "", err
+		}
+		if err == nil && account.revoked(principal.IssuedAt) {
+			authenticated = false
+		}
+	}
+
+	var collectionScope AccessScope
+	for _, rule := range rules {
+		if rule.Role == RoleAnonymous {
+			return "", nil
+		}
+		if !authenticated || !principal.HasRole(rule.Role) {
+			continue
+		}
+		if rule.Scope == "" {
+			return "", nil
+		}
+		if len(vars) == 0 {
+			if collectionScope == "" {
+				collectionScope = rule.Scope
+			}
+			continue
+		}
+
+		granted, err := a.inScope(r.Context(), principal, rule.Scope, vars)
+		if err != nil {
+			return "", err
+		}
+		if granted {
+			return "", nil
+		}
+	}
+	if collectionScope != "" {
+		return collectionScope, nil
+	}
+
+	return "", errForbidden
+}
+
+// inScope reports whether the resource identified by the path variables is related to the caller. Resources
+// that do not exist are in scope, so that the servicer answers with 404 Not Found.
+func (a *Authorizer) inScope(ctx context.Context, principal Principal, scope AccessScope, vars map[string]string) (bool, error) {
+	if scope == AccessScopeSelf {
+		employeeId, ok := vars["employeeId"]
+		if ok {
+			employeeId, ok = resolveEmployeeId(ctx, employeeId)
+		}
+		return ok && principal.EmployeeId != "" && employeeId == principal.EmployeeId, nil
+	}
+
+	customerId, err := a.customerOf(ctx, vars)
+	if errors.Is(err, ErrNotFound) {
+		return true, nil
+	}
+	if err != nil || customerId == "" {
+		return false, err
+	}
+
+	switch scope {
+	case AccessScopeOwn:
+		return principal.CustomerId != "" && customerId == principal.CustomerId, nil
+	case AccessScopeAssigned:
+		if principal.EmployeeId == "" {
+			return false, nil
+		}
+		customer, err := a.repo.GetCustomer(ctx, customerId)
+		if errors.Is(err, ErrNotFound) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return customer.AdvisorId == principal.EmployeeId, nil
+	default:
+		return false, nil
+	}
+}
+
+// customerOf returns the id of the customer the resource identified by the path variables belongs to, or
+// an empty string if the route does not identify a resource of a customer
+func (a *Authorizer) customerOf(ctx context.Context, vars map[string]string) (string, error) {
+	if customerId, ok := vars["customerId"]; ok {
+		return customerId, nil
+	}
+	if contractId, ok := vars["contractId"]; ok {
+		contract, err := a.repo.GetContract(ctx, contractId)
+		return contract.CustomerId, err
+	}
+	if claimId, ok := vars["claimId"]; ok {
+		claim, err := a.repo.GetClaim(ctx, claimId)
+		return claim.CustomerId, err
+	}
+	if mandateId, ok := vars["mandateId"]; ok {
+		mandate, err := a.repo.GetMandate(ctx, mandateId)
+		return mandate.CustomerId, err
+	}
+	if dunningCaseId, ok := vars["dunningCaseId"]; ok {
+		dunningCase, err := a.repo.GetDunningCase(ctx, dunningCaseId)
+		return dunningCase.CustomerId, err
+	}
+	if documentId, ok := vars["documentId"]; ok {
+		document, err := a.repo.GetDocument(ctx, documentId)
+		if err != nil {
+			return "", err
+		}
+		if document.ClaimId != "" {
+			return a.customerOf(ctx, map[string]string{"claimId": document.ClaimId})
+		}
+		return a.customerOf(ctx, map[string]string{"contractId": document.ContractId})
+	}
+
+	return "", nil
+}
+
+// visibleCustomer reports whether a customer is in the scope the request of ctx was granted in
+func visibleCustomer(ctx context.Context, customer CustomerRes) bool {
+	principal, _ := PrincipalFrom(ctx)
+	switch AccessScopeFrom(ctx) {
+	case AccessScopeOwn:
+		return customer.Id == principal.CustomerId
+	case AccessScopeAssigned:
+		return customer.AdvisorId == principal.EmployeeId
+	case AccessScopeSelf:
+		return false
+	default:
+		return true
+	}
+}
+
+// callerName describes the caller of a request in error messages
+func callerName(ctx context.Context) string {
+	if principal, ok := PrincipalFrom(ctx); ok {
+		return principal.Subject
+	}
+
+	return "an anonymous caller"
+}
@@ -0,0 +1,128 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// verificationTokenValidity is how long the token sent to verify the email address of a new account is valid
+	verificationTokenValidity = 24 * time.Hour
+	// resetTokenValidity is how long the token sent to reset a forgotten password is valid
+	resetTokenValidity = time.Hour
+	// invitationTokenValidity is how long the token sent to a new employee to set a password is valid
+	invitationTokenValidity = 7 * 24 * time.Hour
+	// maxFailedLogins is the number of wrong passwords after which an account is locked for loginLockout
+	maxFailedLogins = 5
+	loginLockout    = 15 * time.Minute
+)
+
+// Account is the stored form of a user account of a customer or an employee. Passwords are stored as argon2id
+// hashes and the single-use tokens sent by email only as their SHA-256 hashes.
+type Account struct {
+	Id                      string        `json:"id"`
+	Email                   string        `json:"email"`
+	PasswordHash            string        `json:"passwordHash"`
+	Status                  AccountStatus `json:"status"`
+	Roles                   []string      `json:"roles"`
+	CustomerId              string        `json:"customerId,omitempty"`
+	EmployeeId              string        `json:"employeeId,omitempty"`
+	CreatedAt               string        `json:"createdAt"`
+	VerifiedAt              string        `json:"verifiedAt,omitempty"`
+	VerificationTokenHash   string        `json:"verificationTokenHash,omitempty"`
+	VerificationTokenExpiry string        `json:"verificationTokenExpiry,omitempty"`
+	ResetTokenHash          string        `json:"resetTokenHash,omitempty"`
+	ResetTokenExpiry        string        `json:"resetTokenExpiry,omitempty"`
+	FailedLogins            int           `json:"failedLogins,omitempty"`
+	LockedUntil             string        `json:"lockedUntil,omitempty"`
+	// PasswordChangedAt is when the password was last reset, which revokes the session tokens issued before
+	PasswordChangedAt string `json:"passwordChangedAt,omitempty"`
+	// RolesChangedAt is when the roles of the account last changed, which revokes the session tokens issued
+	// before, since they still grant the previous roles
+	RolesChangedAt string `json:"rolesChangedAt,omitempty"`
+}
+
+// res returns the account as returned by the api, without its password and tokens
+func (a Account) res() AccountRes {
+	return AccountRes{
+		Id:         a.Id,
+		Email:      a.Email,
+		Status:     a.Status,
+		CustomerId: a.CustomerId,
+		EmployeeId: a.EmployeeId,
+		CreatedAt:  a.CreatedAt,
+		VerifiedAt: a.VerifiedAt,
+	}
+}
+
+// principal returns the caller that logged in with the account
+func (a Account) principal() Principal {
+	return Principal{
+		Subject:    a.Id,
+		Method:     AuthMethodBearer,
+		Roles:      a.Roles,
+		CustomerId: a.CustomerId,
+		EmployeeId: a.EmployeeId,
+	}
+}
+
+// locked reports whether the account is locked after too many wrong passwords
+func (a Account) locked(now time.Time) bool {
+	lockedUntil, err := time.Parse(time.RFC3339, a.LockedUntil)
+	return err == nil && now.Before(lockedUntil)
+}
+
+// revoked reports whether a session token issued for the account at issuedAt was revoked by a later password
+// reset or change of roles
+func (a Account) revoked(issuedAt time.Time) bool {
+	for _, revokedAt := range []string{a.PasswordChangedAt, a.RolesChangedAt} {
+		changedAt, err := time.Parse(time.RFC3339Nano, revokedAt)
+		if err == nil && issuedAt.Before(changedAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validToken reports whether token matches the stored hash of a token that has not expired yet
+func validToken(token string, hash string, expiry string, now time.Time) bool {
+	expiresAt, err := time.Parse(time.RFC3339, expiry)
+	return hash != "" && err == nil && now.Before(expiresAt) && secretTokenHash(token) == hash
+}
+
+// normalizeEmail returns the form email addresses are compared in, which ignores case
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// findAccount returns the first account matching, or ErrNotFound if there is none
+func findAccount(ctx context.Context, repo Repository, match func(Account) bool) (Account, error) {
+	accounts, err := repo.ListAccounts(ctx)
+	if err != nil {
+		return Account{}, err
+	}
+	for _, account := range accounts {
+		if match(account) {
+			return account, nil
+		}
+	}
+
+	return Account{}, ErrNotFound
+}
+
+// portalLink returns the link to a page of the portal at portalURL passing it the token
+func portalLink(portalURL string, path string, token string) string {
+	return strings.TrimSuffix(portalURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,60 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccountRevoked(t *testing.T) {
+	issuedAt := time.Date(2026, 5, 4, 10, 0, 0, 123456000, time.UTC)
+	tests := []struct {
+		name    string
+		account Account
+		want    bool
+	}{
+		{name: "never changed", account: Account{}},
+		{name: "password reset before", account: Account{PasswordChangedAt: "2026-05-04T09:59:59.999999Z"}},
+		{name: "password reset after", account: Account{PasswordChangedAt: "2026-05-04T10:00:00.123457Z"}, want: true},
+		{name: "roles changed before", account: Account{RolesChangedAt: "2026-05-04T10:00:00.123455Z"}},
+		{name: "roles changed after", account: Account{RolesChangedAt: "2026-05-04T11:00:00Z"}, want: true},
+		{name: "roles changed after an earlier password reset", account: Account{PasswordChangedAt: "2026-05-01T00:00:00Z", RolesChangedAt: "2026-05-05T00:00:00Z"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.account.revoked(issuedAt); got != tt.want {
+				t.Errorf("revoked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameRoles(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want bool
+	}{
+		{name: "equal", a: []string{"employee", "admin"}, b: []string{"employee", "admin"}, want: true},
+		{name: "other order", a: []string{"employee", "admin"}, b: []string{"admin", "employee"}, want: true},
+		{name: "role added", a: []string{"employee"}, b: []string{"employee", "admin"}},
+		{name: "role removed", a: []string{"employee", "admin"}, b: []string{"employee"}},
+		{name: "role replaced", a: []string{"employee"}, b: []string{"admin"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameRoles(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameRoles(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
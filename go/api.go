@@ -12,39 +12,172 @@ package openapi
 import (
 	"context"
 	"net/http"
+	"os"
 )
 
 
 
+// AccountAPIRouter defines the required methods for binding the api requests to a responses for the AccountAPI
+// The AccountAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a AccountAPIServicer to perform the required actions, then write the service results to the http response.
+type AccountAPIRouter interface { 
+	Login(http.ResponseWriter, *http.Request)
+	RegisterAccount(http.ResponseWriter, *http.Request)
+	RequestPasswordReset(http.ResponseWriter, *http.Request)
+	ResetPassword(http.ResponseWriter, *http.Request)
+	VerifyAccount(http.ResponseWriter, *http.Request)
+}
+// BillingAPIRouter defines the required methods for binding the api requests to a responses for the BillingAPI
+// The BillingAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a BillingAPIServicer to perform the required actions, then write the service results to the http response.
+type BillingAPIRouter interface { 
+	CreateBillingRun(http.ResponseWriter, *http.Request)
+	DownloadBillingRunFile(http.ResponseWriter, *http.Request)
+	GetBillingRun(http.ResponseWriter, *http.Request)
+	GetBillingRuns(http.ResponseWriter, *http.Request)
+	GetContractInstallments(http.ResponseWriter, *http.Request)
+	GetContractInvoices(http.ResponseWriter, *http.Request)
+}
+// ClaimAPIRouter defines the required methods for binding the api requests to a responses for the ClaimAPI
+// The ClaimAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a ClaimAPIServicer to perform the required actions, then write the service results to the http response.
+type ClaimAPIRouter interface { 
+	AssignClaim(http.ResponseWriter, *http.Request)
+	CreateClaim(http.ResponseWriter, *http.Request)
+	DecideClaim(http.ResponseWriter, *http.Request)
+	GetClaim(http.ResponseWriter, *http.Request)
+	GetClaimQueue(http.ResponseWriter, *http.Request)
+	GetContractClaims(http.ResponseWriter, *http.Request)
+	GetCustomerClaims(http.ResponseWriter, *http.Request)
+	GetEmployeeClaims(http.ResponseWriter, *http.Request)
+	PayClaim(http.ResponseWriter, *http.Request)
+	PickClaim(http.ResponseWriter, *http.Request)
+}
 // ContractAPIRouter defines the required methods for binding the api requests to a responses for the ContractAPI
 // The ContractAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a ContractAPIServicer to perform the required actions, then write the service results to the http response.
 type ContractAPIRouter interface { 
+	ActivateContract(http.ResponseWriter, *http.Request)
+	AmendContract(http.ResponseWriter, *http.Request)
 	CalculateRate(http.ResponseWriter, *http.Request)
+	CancelContract(http.ResponseWriter, *http.Request)
 	CreateContract(http.ResponseWriter, *http.Request)
+	ExplainRate(http.ResponseWriter, *http.Request)
 	GetContract(http.ResponseWriter, *http.Request)
+	GetContractVersions(http.ResponseWriter, *http.Request)
 	GetCustomerContracts(http.ResponseWriter, *http.Request)
+	GetQuote(http.ResponseWriter, *http.Request)
+	ReinstateContract(http.ResponseWriter, *http.Request)
+	SuspendContract(http.ResponseWriter, *http.Request)
 }
 // CustomerAPIRouter defines the required methods for binding the api requests to a responses for the CustomerAPI
 // The CustomerAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a CustomerAPIServicer to perform the required actions, then write the service results to the http response.
 type CustomerAPIRouter interface { 
+	AssignCustomer(http.ResponseWriter, *http.Request)
 	CreateCustomer(http.ResponseWriter, *http.Request)
 	DeleteCustomer(http.ResponseWriter, *http.Request)
 	GetCustomer(http.ResponseWriter, *http.Request)
 	GetCustomerContracts(http.ResponseWriter, *http.Request)
 	GetCustomers(http.ResponseWriter, *http.Request)
+	GetEmployeeCustomers(http.ResponseWriter, *http.Request)
+	ReassignCustomers(http.ResponseWriter, *http.Request)
 	SearchCustomers(http.ResponseWriter, *http.Request)
 	UpdateCustomer(http.ResponseWriter, *http.Request)
 }
+// DocumentAPIRouter defines the required methods for binding the api requests to a responses for the DocumentAPI
+// The DocumentAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a DocumentAPIServicer to perform the required actions, then write the service results to the http response.
+type DocumentAPIRouter interface { 
+	DownloadDocument(http.ResponseWriter, *http.Request)
+	GetClaimDocuments(http.ResponseWriter, *http.Request)
+	GetContractDocuments(http.ResponseWriter, *http.Request)
+	GetDocument(http.ResponseWriter, *http.Request)
+	UploadClaimDocuments(http.ResponseWriter, *http.Request)
+	UploadContractDocuments(http.ResponseWriter, *http.Request)
+}
+// DunningAPIRouter defines the required methods for binding the api requests to a responses for the DunningAPI
+// The DunningAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a DunningAPIServicer to perform the required actions, then write the service results to the http response.
+type DunningAPIRouter interface { 
+	GetDunningCase(http.ResponseWriter, *http.Request)
+	GetDunningCases(http.ResponseWriter, *http.Request)
+}
 // EmployeeAPIRouter defines the required methods for binding the api requests to a responses for the EmployeeAPI
 // The EmployeeAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a EmployeeAPIServicer to perform the required actions, then write the service results to the http response.
 type EmployeeAPIRouter interface { 
 	CreateEmployee(http.ResponseWriter, *http.Request)
+	DeleteEmployee(http.ResponseWriter, *http.Request)
 	GetEmployee(http.ResponseWriter, *http.Request)
+	GetEmployees(http.ResponseWriter, *http.Request)
+	ReactivateEmployee(http.ResponseWriter, *http.Request)
 	UpdateEmployee(http.ResponseWriter, *http.Request)
 }
+// MandateAPIRouter defines the required methods for binding the api requests to a responses for the MandateAPI
+// The MandateAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a MandateAPIServicer to perform the required actions, then write the service results to the http response.
+type MandateAPIRouter interface { 
+	CreateMandate(http.ResponseWriter, *http.Request)
+	GetContractMandates(http.ResponseWriter, *http.Request)
+	GetCustomerMandates(http.ResponseWriter, *http.Request)
+	GetMandate(http.ResponseWriter, *http.Request)
+	RevokeMandate(http.ResponseWriter, *http.Request)
+}
+// PaymentAPIRouter defines the required methods for binding the api requests to a responses for the PaymentAPI
+// The PaymentAPIRouter implementation should parse necessary information from the http request,
+// pass the data to a PaymentAPIServicer to perform the required actions, then write the service results to the http response.
+type PaymentAPIRouter interface { 
+	GetBankStatement(http.ResponseWriter, *http.Request)
+	GetStatementEntries(http.ResponseWriter, *http.Request)
+	ImportBankStatement(http.ResponseWriter, *http.Request)
+	ResolveStatementEntry(http.ResponseWriter, *http.Request)
+}
+
+
+// AccountAPIServicer defines the api actions for the AccountAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type AccountAPIServicer interface { 
+	Login(context.Context, LoginReq) (ImplResponse, error)
+	RegisterAccount(context.Context, AccountRegistrationReq) (ImplResponse, error)
+	RequestPasswordReset(context.Context, PasswordResetReq) (ImplResponse, error)
+	ResetPassword(context.Context, PasswordResetConfirmationReq) (ImplResponse, error)
+	VerifyAccount(context.Context, AccountVerificationReq) (ImplResponse, error)
+}
+
+
+// BillingAPIServicer defines the api actions for the BillingAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type BillingAPIServicer interface { 
+	CreateBillingRun(context.Context, BillingRunReq) (ImplResponse, error)
+	DownloadBillingRunFile(context.Context, string) (ImplResponse, error)
+	GetBillingRun(context.Context, string) (ImplResponse, error)
+	GetBillingRuns(context.Context, int32, int32) (ImplResponse, error)
+	GetContractInstallments(context.Context, string, int32, int32) (ImplResponse, error)
+	GetContractInvoices(context.Context, string, int32, int32) (ImplResponse, error)
+}
+
+
+// ClaimAPIServicer defines the api actions for the ClaimAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type ClaimAPIServicer interface { 
+	AssignClaim(context.Context, string, ClaimAssignmentReq) (ImplResponse, error)
+	CreateClaim(context.Context, string, ClaimReq) (ImplResponse, error)
+	DecideClaim(context.Context, string, ClaimDecisionReq) (ImplResponse, error)
+	GetClaim(context.Context, string) (ImplResponse, error)
+	GetClaimQueue(context.Context, int32, int32) (ImplResponse, error)
+	GetContractClaims(context.Context, string, int32, int32) (ImplResponse, error)
+	GetCustomerClaims(context.Context, string, int32, int32) (ImplResponse, error)
+	GetEmployeeClaims(context.Context, string, int32, int32) (ImplResponse, error)
+	PayClaim(context.Context, string, ClaimPaymentReq) (ImplResponse, error)
+	PickClaim(context.Context, string) (ImplResponse, error)
+}
 
 
 // ContractAPIServicer defines the api actions for the ContractAPI service
@@ -52,10 +185,18 @@ type EmployeeAPIRouter interface {
 // while the service implementation can be ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type ContractAPIServicer interface { 
-	CalculateRate(context.Context, RateCalculationReq) (ImplResponse, error)
+	ActivateContract(context.Context, string) (ImplResponse, error)
+	AmendContract(context.Context, string, ContractAmendmentReq) (ImplResponse, error)
+	CalculateRate(context.Context, RateCalculationReq, bool) (ImplResponse, error)
+	CancelContract(context.Context, string, ContractCancellationReq) (ImplResponse, error)
 	CreateContract(context.Context, ContractReq) (ImplResponse, error)
+	ExplainRate(context.Context, RateCalculationReq) (ImplResponse, error)
 	GetContract(context.Context, string) (ImplResponse, error)
-	GetCustomerContracts(context.Context, string, int32, int32) (ImplResponse, error)
+	GetContractVersions(context.Context, string) (ImplResponse, error)
+	GetCustomerContracts(context.Context, string, int32, int32, ContractStatus) (ImplResponse, error)
+	GetQuote(context.Context, string) (ImplResponse, error)
+	ReinstateContract(context.Context, string) (ImplResponse, error)
+	SuspendContract(context.Context, string) (ImplResponse, error)
 }
 
 
@@ -64,22 +205,77 @@ type ContractAPIServicer interface {
 // while the service implementation can be ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type CustomerAPIServicer interface { 
+	AssignCustomer(context.Context, string, AdvisorAssignmentReq) (ImplResponse, error)
 	CreateCustomer(context.Context, CustomerReq) (ImplResponse, error)
 	DeleteCustomer(context.Context, string) (ImplResponse, error)
 	GetCustomer(context.Context, string) (ImplResponse, error)
-	GetCustomerContracts(context.Context, string, int32, int32) (ImplResponse, error)
+	GetCustomerContracts(context.Context, string, int32, int32, ContractStatus) (ImplResponse, error)
 	GetCustomers(context.Context, int32, int32) (ImplResponse, error)
+	GetEmployeeCustomers(context.Context, string, int32, int32) (ImplResponse, error)
+	ReassignCustomers(context.Context, string, AdvisorAssignmentReq) (ImplResponse, error)
 	SearchCustomers(context.Context, string, int32, int32) (ImplResponse, error)
 	UpdateCustomer(context.Context, string, CustomerReq) (ImplResponse, error)
 }
 
 
+// DocumentAPIServicer defines the api actions for the DocumentAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type DocumentAPIServicer interface { 
+	DownloadDocument(context.Context, string) (ImplResponse, error)
+	GetClaimDocuments(context.Context, string) (ImplResponse, error)
+	GetContractDocuments(context.Context, string) (ImplResponse, error)
+	GetDocument(context.Context, string) (ImplResponse, error)
+	UploadClaimDocuments(context.Context, string, DocumentKind, []*os.File) (ImplResponse, error)
+	UploadContractDocuments(context.Context, string, DocumentKind, []*os.File) (ImplResponse, error)
+}
+
+
+// DunningAPIServicer defines the api actions for the DunningAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type DunningAPIServicer interface { 
+	GetDunningCase(context.Context, string) (ImplResponse, error)
+	GetDunningCases(context.Context, int32, int32, DunningCaseStatus) (ImplResponse, error)
+}
+
+
 // EmployeeAPIServicer defines the api actions for the EmployeeAPI service
 // This interface intended to stay up to date with the openapi yaml used to generate it,
 // while the service implementation can be ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type EmployeeAPIServicer interface { 
 	CreateEmployee(context.Context, EmployeeReq) (ImplResponse, error)
+	DeleteEmployee(context.Context, string) (ImplResponse, error)
 	GetEmployee(context.Context, string) (ImplResponse, error)
-	UpdateEmployee(context.Context, EmployeeReq) (ImplResponse, error)
+	GetEmployees(context.Context, int32, int32, EmployeeStatus, EmployeeRole, string) (ImplResponse, error)
+	ReactivateEmployee(context.Context, string) (ImplResponse, error)
+	UpdateEmployee(context.Context, string, EmployeeUpdateReq) (ImplResponse, error)
 }
+
+
+// MandateAPIServicer defines the api actions for the MandateAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type MandateAPIServicer interface { 
+	CreateMandate(context.Context, string, MandateReq) (ImplResponse, error)
+	GetContractMandates(context.Context, string, int32, int32) (ImplResponse, error)
+	GetCustomerMandates(context.Context, string, int32, int32) (ImplResponse, error)
+	GetMandate(context.Context, string) (ImplResponse, error)
+	RevokeMandate(context.Context, string, MandateRevocationReq) (ImplResponse, error)
+}
+
+// PaymentAPIServicer defines the api actions for the PaymentAPI service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can be ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type PaymentAPIServicer interface { 
+	GetBankStatement(context.Context, string) (ImplResponse, error)
+	GetStatementEntries(context.Context, int32, int32, StatementEntryStatus) (ImplResponse, error)
+	ImportBankStatement(context.Context, *os.File) (ImplResponse, error)
+	ResolveStatementEntry(context.Context, string, StatementEntryResolutionReq) (ImplResponse, error)
+}
+
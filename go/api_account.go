@@ -0,0 +1,192 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// AccountAPIController binds http requests to an api service and writes the service results to the http response
+type AccountAPIController struct {
+	service AccountAPIServicer
+	errorHandler ErrorHandler
+}
+
+// AccountAPIOption for how the controller is set up.
+type AccountAPIOption func(*AccountAPIController)
+
+// WithAccountAPIErrorHandler inject ErrorHandler into controller
+func WithAccountAPIErrorHandler(h ErrorHandler) AccountAPIOption {
+	return func(c *AccountAPIController) {
+		c.errorHandler = h
+	}
+}
+
+// NewAccountAPIController creates a default api controller
+func NewAccountAPIController(s AccountAPIServicer, opts ...AccountAPIOption) Router {
+	controller := &AccountAPIController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the AccountAPIController
+func (c *AccountAPIController) Routes() Routes {
+	return Routes{
+		"Login": Route{
+			strings.ToUpper("Post"),
+			"/v1/sessions",
+			c.Login,
+		},
+		"RegisterAccount": Route{
+			strings.ToUpper("Post"),
+			"/v1/accounts",
+			c.RegisterAccount,
+		},
+		"RequestPasswordReset": Route{
+			strings.ToUpper("Post"),
+			"/v1/accounts/password-reset",
+			c.RequestPasswordReset,
+		},
+		"ResetPassword": Route{
+			strings.ToUpper("Post"),
+			"/v1/accounts/password-reset/confirm",
+			c.ResetPassword,
+		},
+		"VerifyAccount": Route{
+			strings.ToUpper("Post"),
+			"/v1/accounts/verify",
+			c.VerifyAccount,
+		},
+	}
+}
+
+// Login - Log in with email address and password
+func (c *AccountAPIController) Login(w http.ResponseWriter, r *http.Request) {
+	loginReqParam := LoginReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&loginReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertLoginReqRequired(loginReqParam), AssertLoginReqConstraints(loginReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.Login(r.Context(), loginReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// RegisterAccount - Register an account for a customer
+func (c *AccountAPIController) RegisterAccount(w http.ResponseWriter, r *http.Request) {
+	accountRegistrationReqParam := AccountRegistrationReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&accountRegistrationReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertAccountRegistrationReqRequired(accountRegistrationReqParam), AssertAccountRegistrationReqConstraints(accountRegistrationReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.RegisterAccount(r.Context(), accountRegistrationReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// RequestPasswordReset - Request an email to reset a forgotten password
+func (c *AccountAPIController) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	passwordResetReqParam := PasswordResetReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&passwordResetReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertPasswordResetReqRequired(passwordResetReqParam), AssertPasswordResetReqConstraints(passwordResetReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.RequestPasswordReset(r.Context(), passwordResetReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ResetPassword - Set a new password with a password reset token
+func (c *AccountAPIController) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	passwordResetConfirmationReqParam := PasswordResetConfirmationReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&passwordResetConfirmationReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertPasswordResetConfirmationReqRequired(passwordResetConfirmationReqParam), AssertPasswordResetConfirmationReqConstraints(passwordResetConfirmationReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ResetPassword(r.Context(), passwordResetConfirmationReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// VerifyAccount - Verify the email address of an account
+func (c *AccountAPIController) VerifyAccount(w http.ResponseWriter, r *http.Request) {
+	accountVerificationReqParam := AccountVerificationReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&accountVerificationReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertAccountVerificationReqRequired(accountVerificationReqParam), AssertAccountVerificationReqConstraints(accountVerificationReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.VerifyAccount(r.Context(), accountVerificationReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
@@ -0,0 +1,308 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// errInvalidCredentials is returned for every failed login, so that the response does not tell whether the
+// account exists, the password is wrong or the account is locked
+var errInvalidCredentials = errors.New("invalid email address or password")
+
+// AccountAPIService is a service that implements the logic for the AccountAPIServicer
+// This service should implement the business logic for every endpoint for the AccountAPI API.
+// Include any external packages or services that will be required by this service.
+type AccountAPIService struct {
+	repo      Repository
+	issuer    *TokenIssuer
+	mailer    Mailer
+	portalURL string
+	now       func() time.Time
+	// mu serializes changes of accounts, so that every token is used at most once
+	mu sync.Mutex
+}
+
+// NewAccountAPIService creates a default api service. Its emails link to the pages of the customer portal at
+// portalURL, which pass the tokens on to the api.
+func NewAccountAPIService(repo Repository, issuer *TokenIssuer, mailer Mailer, portalURL string) AccountAPIServicer {
+	return &AccountAPIService{
+		repo:      repo,
+		issuer:    issuer,
+		mailer:    mailer,
+		portalURL: portalURL,
+		now:       time.Now,
+	}
+}
+
+// Login - Log in with email address and password
+func (s *AccountAPIService) Login(ctx context.Context, loginReq LoginReq) (ImplResponse, error) {
+	// The password is verified before taking the lock, so that logins do not wait for each other's hashing. Unknown
+	// accounts, accounts without a password and locked accounts are verified against a dummy hash or their own, so
+	// that the response time does not tell them apart.
+	account, err := s.repo.FindAccountByEmail(ctx, loginReq.Email)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	passwordHash := account.PasswordHash
+	if passwordHash == "" {
+		passwordHash = dummyPasswordHash
+	}
+	ok, err := verifyPassword(loginReq.Password, passwordHash)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), fmt.Errorf("password of account %s: %w", account.Id, err)
+	}
+	if account.PasswordHash == "" {
+		return Response(http.StatusUnauthorized, nil), errInvalidCredentials
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Failed logins are counted on the stored account, which concurrent logins may have changed meanwhile
+	account, err = s.repo.GetAccount(ctx, account.Id)
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusUnauthorized, nil), errInvalidCredentials
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	now := s.now()
+	if account.locked(now) || account.PasswordHash != passwordHash {
+		return Response(http.StatusUnauthorized, nil), errInvalidCredentials
+	}
+	if !ok {
+		account.FailedLogins++
+		if account.FailedLogins >= maxFailedLogins {
+			account.FailedLogins = 0
+			account.LockedUntil = now.Add(loginLockout).UTC().Format(time.RFC3339)
+		}
+		if err := s.repo.UpdateAccount(ctx, account); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+		return Response(http.StatusUnauthorized, nil), errInvalidCredentials
+	}
+	switch account.Status {
+	case AccountStatusPending:
+		return Response(http.StatusForbidden, nil), errors.New("the email address of the account is not verified yet")
+	case AccountStatusDisabled:
+		return Response(http.StatusForbidden, nil), errors.New("the account is disabled")
+	}
+
+	if account.FailedLogins != 0 || account.LockedUntil != "" {
+		account.FailedLogins = 0
+		account.LockedUntil = ""
+		if err := s.repo.UpdateAccount(ctx, account); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
+
+	token, expiresAt, err := s.issuer.issue(account.principal(), now)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusCreated, SessionRes{
+		Token:     token,
+		TokenType: "Bearer",
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+	}), nil
+}
+
+// RegisterAccount - Register an account for a customer
+func (s *AccountAPIService) RegisterAccount(ctx context.Context, accountRegistrationReq AccountRegistrationReq) (ImplResponse, error) {
+	// The password is hashed before taking the lock, like in Login
+	passwordHash, err := hashPassword(accountRegistrationReq.Password)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	customer, err := s.repo.GetCustomer(ctx, accountRegistrationReq.CustomerId)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	// Both cases are answered alike, so that the response does not tell which customers exist
+	if errors.Is(err, ErrNotFound) || normalizeEmail(customer.Email) != normalizeEmail(accountRegistrationReq.Email) {
+		return Response(http.StatusBadRequest, nil), errors.New("the email address does not match the one stored for the customer")
+	}
+
+	accounts, err := s.repo.ListAccounts(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	var existing *Account
+	for i, account := range accounts {
+		if account.CustomerId != customer.Id && normalizeEmail(account.Email) != normalizeEmail(customer.Email) {
+			continue
+		}
+		if account.CustomerId != customer.Id || account.Status != AccountStatusPending {
+			return Response(http.StatusConflict, nil), fmt.Errorf("there already is an account for customer %s or the email address %s", customer.Id, accountRegistrationReq.Email)
+		}
+		existing = &accounts[i]
+	}
+
+	token, tokenHash, err := newSecretToken()
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	// A pending registration is replaced, e.g. if the verification email got lost
+	now := s.now().UTC()
+	account := Account{
+		Id:                      newId(),
+		Email:                   accountRegistrationReq.Email,
+		PasswordHash:            passwordHash,
+		Status:                  AccountStatusPending,
+		Roles:                   []string{RoleCustomer},
+		CustomerId:              customer.Id,
+		CreatedAt:               now.Format(time.RFC3339),
+		VerificationTokenHash:   tokenHash,
+		VerificationTokenExpiry: now.Add(verificationTokenValidity).Format(time.RFC3339),
+	}
+	if existing != nil {
+		account.Id = existing.Id
+		err = s.repo.UpdateAccount(ctx, account)
+	} else {
+		err = s.repo.CreateAccount(ctx, account)
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	err = s.mailer.Send(ctx, Mail{
+		To:      account.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Dear %s %s,\n\nplease verify your email address to activate your account:\n%s\n\nThe link is valid until %s.\n",
+			customer.FirstName, customer.LastName, portalLink(s.portalURL, "/verify", token), account.VerificationTokenExpiry),
+	})
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusCreated, account.res()), nil
+}
+
+// RequestPasswordReset - Request an email to reset a forgotten password
+func (s *AccountAPIService) RequestPasswordReset(ctx context.Context, passwordResetReq PasswordResetReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Unknown and unverified email addresses are accepted without sending an email, so that the response does
+	// not tell whether an account exists
+	account, err := s.repo.FindAccountByEmail(ctx, passwordResetReq.Email)
+	if errors.Is(err, ErrNotFound) || (err == nil && account.Status != AccountStatusActive) {
+		return Response(http.StatusAccepted, nil), nil
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	token, tokenHash, err := newSecretToken()
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	account.ResetTokenHash = tokenHash
+	account.ResetTokenExpiry = s.now().Add(resetTokenValidity).UTC().Format(time.RFC3339)
+	if err := s.repo.UpdateAccount(ctx, account); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	err = s.mailer.Send(ctx, Mail{
+		To:      account.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("A new password was requested for your account. Set it until %s:\n%s\n\n"+
+			"If you did not request it, ignore this email and your password stays unchanged.\n",
+			account.ResetTokenExpiry, portalLink(s.portalURL, "/reset-password", token)),
+	})
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusAccepted, nil), nil
+}
+
+// ResetPassword - Set a new password with a password reset token
+// The token is either requested with RequestPasswordReset or sent with the invitation of a new employee.
+func (s *AccountAPIService) ResetPassword(ctx context.Context, passwordResetConfirmationReq PasswordResetConfirmationReq) (ImplResponse, error) {
+	// The new password is hashed before taking the lock, like in Login
+	passwordHash, err := hashPassword(passwordResetConfirmationReq.Password)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, err := findAccount(ctx, s.repo, func(account Account) bool {
+		return account.Status != AccountStatusDisabled &&
+			validToken(passwordResetConfirmationReq.Token, account.ResetTokenHash, account.ResetTokenExpiry, s.now())
+	})
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusBadRequest, nil), errors.New("the password reset token is invalid or expired")
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	// The sessions of the old password are revoked, see Account.revoked
+	account.PasswordHash = passwordHash
+	account.PasswordChangedAt = s.now().UTC().Format(time.RFC3339Nano)
+	account.ResetTokenHash = ""
+	account.ResetTokenExpiry = ""
+	account.FailedLogins = 0
+	account.LockedUntil = ""
+	// The token of an invitation was sent to the email address of a pending account, which is verified with it
+	if account.Status == AccountStatusPending {
+		account.Status = AccountStatusActive
+		account.VerifiedAt = s.now().UTC().Format(time.RFC3339)
+		account.VerificationTokenHash = ""
+		account.VerificationTokenExpiry = ""
+	}
+	if err := s.repo.UpdateAccount(ctx, account); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, account.res()), nil
+}
+
+// VerifyAccount - Verify the email address of an account
+func (s *AccountAPIService) VerifyAccount(ctx context.Context, accountVerificationReq AccountVerificationReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, err := findAccount(ctx, s.repo, func(account Account) bool {
+		return validToken(accountVerificationReq.Token, account.VerificationTokenHash, account.VerificationTokenExpiry, s.now())
+	})
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusBadRequest, nil), errors.New("the verification token is invalid or expired")
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	account.Status = AccountStatusActive
+	account.VerifiedAt = s.now().UTC().Format(time.RFC3339)
+	account.VerificationTokenHash = ""
+	account.VerificationTokenExpiry = ""
+	if err := s.repo.UpdateAccount(ctx, account); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, account.res()), nil
+}
@@ -0,0 +1,322 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// BillingAPIController binds http requests to an api service and writes the service results to the http response
+type BillingAPIController struct {
+	service BillingAPIServicer
+	errorHandler ErrorHandler
+}
+
+// BillingAPIOption for how the controller is set up.
+type BillingAPIOption func(*BillingAPIController)
+
+// WithBillingAPIErrorHandler inject ErrorHandler into controller
+func WithBillingAPIErrorHandler(h ErrorHandler) BillingAPIOption {
+	return func(c *BillingAPIController) {
+		c.errorHandler = h
+	}
+}
+
+// NewBillingAPIController creates a default api controller
+func NewBillingAPIController(s BillingAPIServicer, opts ...BillingAPIOption) Router {
+	controller := &BillingAPIController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the BillingAPIController
+func (c *BillingAPIController) Routes() Routes {
+	return Routes{
+		"CreateBillingRun": Route{
+			strings.ToUpper("Post"),
+			"/v1/billing-runs",
+			c.CreateBillingRun,
+		},
+		"DownloadBillingRunFile": Route{
+			strings.ToUpper("Get"),
+			"/v1/billing-runs/{billingRunId}/file",
+			c.DownloadBillingRunFile,
+		},
+		"GetBillingRun": Route{
+			strings.ToUpper("Get"),
+			"/v1/billing-runs/{billingRunId}",
+			c.GetBillingRun,
+		},
+		"GetBillingRuns": Route{
+			strings.ToUpper("Get"),
+			"/v1/billing-runs",
+			c.GetBillingRuns,
+		},
+		"GetContractInstallments": Route{
+			strings.ToUpper("Get"),
+			"/v1/contracts/{contractId}/installments",
+			c.GetContractInstallments,
+		},
+		"GetContractInvoices": Route{
+			strings.ToUpper("Get"),
+			"/v1/contracts/{contractId}/invoices",
+			c.GetContractInvoices,
+		},
+	}
+}
+
+// CreateBillingRun - Collect the premiums due by SEPA direct debit
+func (c *BillingAPIController) CreateBillingRun(w http.ResponseWriter, r *http.Request) {
+	billingRunReqParam := BillingRunReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&billingRunReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertBillingRunReqRequired(billingRunReqParam), AssertBillingRunReqConstraints(billingRunReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateBillingRun(r.Context(), billingRunReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DownloadBillingRunFile - Download the pain.008 file of a billing run
+func (c *BillingAPIController) DownloadBillingRunFile(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	billingRunIdParam := params["billingRunId"]
+	if billingRunIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"billingRunId"}, nil)
+		return
+	}
+	if err := validatePathUuid("billingRunId", billingRunIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DownloadBillingRunFile(r.Context(), billingRunIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	if file, ok := result.Body.(*os.File); ok {
+		defer file.Close()
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetBillingRun - Get billing run details
+func (c *BillingAPIController) GetBillingRun(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	billingRunIdParam := params["billingRunId"]
+	if billingRunIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"billingRunId"}, nil)
+		return
+	}
+	if err := validatePathUuid("billingRunId", billingRunIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetBillingRun(r.Context(), billingRunIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetBillingRuns - Get the billing runs, latest first
+func (c *BillingAPIController) GetBillingRuns(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetBillingRuns(r.Context(), pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetContractInstallments - Get the installments of a contract
+func (c *BillingAPIController) GetContractInstallments(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetContractInstallments(r.Context(), contractIdParam, pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetContractInvoices - Get the invoices of a contract
+func (c *BillingAPIController) GetContractInvoices(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetContractInvoices(r.Context(), contractIdParam, pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
@@ -0,0 +1,377 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BillingAPIService is a service that implements the logic for the BillingAPIServicer
+// This service should implement the business logic for every endpoint for the BillingAPI API.
+// Include any external packages or services that will be required by this service.
+type BillingAPIService struct {
+	repo     Repository
+	blobs    BlobStore
+	creditor Creditor
+	now      func() time.Time
+	// mu serializes billing runs, so no installment is collected twice. It is shared with the PaymentAPIService
+	// and the MandateAPIService, so that a billing run does not overwrite the changes of a bank statement import
+	// to installments, nor collects with a mandate revoked during the run.
+	mu *sync.Mutex
+}
+
+// NewBillingAPIService creates a default api service collecting premiums for the creditor and keeping the
+// pain.008 files in blobs. collections is the lock the PaymentAPIService and the MandateAPIService change
+// installments and mandates under.
+func NewBillingAPIService(repo Repository, blobs BlobStore, creditor Creditor, collections *sync.Mutex) BillingAPIServicer {
+	return &BillingAPIService{
+		repo:     repo,
+		blobs:    blobs,
+		creditor: creditor,
+		now:      time.Now,
+		mu:       collections,
+	}
+}
+
+// CreateBillingRun - Collect the premiums due by SEPA direct debit
+func (s *BillingAPIService) CreateBillingRun(ctx context.Context, billingRunReq BillingRunReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	today, _ := time.Parse(dateLayout, now.Format(dateLayout))
+	dueUntil := today
+	if billingRunReq.DueUntil != "" {
+		parsed, err := time.Parse(dateLayout, billingRunReq.DueUntil)
+		if err != nil {
+			return Response(http.StatusBadRequest, nil), fmt.Errorf("dueUntil %q is not a valid date", billingRunReq.DueUntil)
+		}
+		dueUntil = parsed
+	}
+
+	list, err := s.repo.ListContracts(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	contracts := make(map[string]ContractRes)
+	for _, contract := range list {
+		contracts[contract.Id] = contract
+	}
+	installments, created, err := s.dueInstallments(ctx, contracts, dueUntil)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	mandates, err := s.activeMandates(ctx, today)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	run := BillingRunRes{
+		Id:        newId(),
+		CreatedAt: now.UTC().Format(time.RFC3339),
+		DueUntil:  dueUntil.Format(dateLayout),
+		DryRun:    billingRunReq.DryRun,
+		Batches:   []BillingBatch{},
+	}
+	run.MessageId = "CI" + now.UTC().Format("20060102150405") + "-" + strings.ToUpper(strings.ReplaceAll(run.Id, "-", "")[:12])
+
+	type batchKey struct {
+		collectionDate string
+		sequenceType   MandateSequenceType
+	}
+	batches := make(map[batchKey]*BillingBatch)
+	debits := make(map[string]directDebit)
+	used := make(map[string]bool)
+	earliest := earliestCollectionDate(today)
+	var total int64
+
+	for i := range installments {
+		installment := &installments[i]
+		contract, ok := contracts[installment.ContractId]
+		if !ok {
+			run.Skipped = append(run.Skipped, skippedInstallment(*installment, "contract does not exist"))
+			continue
+		}
+		if contract.Status != ContractStatusActive {
+			run.Skipped = append(run.Skipped, skippedInstallment(*installment, fmt.Sprintf("contract is %s", contract.Status)))
+			continue
+		}
+		mandate, ok := mandates[installment.ContractId]
+		if !ok {
+			run.Skipped = append(run.Skipped, skippedInstallment(*installment, "contract has no active mandate"))
+			continue
+		}
+
+		collection, _ := time.Parse(dateLayout, installment.DueDate)
+		if collection.Before(earliest) {
+			collection = earliest
+		}
+		collection = nextBusinessDay(collection)
+
+		installment.Status = InstallmentStatusSubmitted
+		installment.BillingRunId = run.Id
+		installment.MandateId = mandate.Id
+		installment.MandateReference = mandate.Reference
+		installment.EndToEndId = strings.ReplaceAll(installment.Id, "-", "")
+		installment.SequenceType = mandate.SequenceType
+		installment.CollectionDate = collection.Format(dateLayout)
+		debits[installment.Id] = directDebit{
+			installment: *installment,
+			mandate:     mandate,
+			remittance:  fmt.Sprintf("Cat insurance premium for %s, contract %s, due %s", contract.CatName, contract.Id, installment.DueDate),
+		}
+
+		key := batchKey{installment.CollectionDate, installment.SequenceType}
+		batch, ok := batches[key]
+		if !ok {
+			batch = &BillingBatch{CollectionDate: key.collectionDate, SequenceType: key.sequenceType, InstallmentIds: []string{}}
+			batches[key] = batch
+		}
+		batch.InstallmentIds = append(batch.InstallmentIds, installment.Id)
+		batch.NumberOfTransactions++
+		batch.ControlSum = fromCents(toCents(batch.ControlSum) + toCents(installment.Amount))
+		run.NumberOfTransactions++
+		total += toCents(installment.Amount)
+
+		// Every further direct debit with the mandate is a recurring one
+		mandate.SequenceType = MandateSequenceTypeRcur
+		if installment.CollectionDate > mandate.LastCollectionDate {
+			mandate.LastCollectionDate = installment.CollectionDate
+		}
+		mandates[installment.ContractId] = mandate
+		used[installment.ContractId] = true
+	}
+	run.ControlSum = fromCents(total)
+
+	for _, batch := range batches {
+		run.Batches = append(run.Batches, *batch)
+	}
+	sort.Slice(run.Batches, func(i, j int) bool {
+		if run.Batches[i].CollectionDate != run.Batches[j].CollectionDate {
+			return run.Batches[i].CollectionDate < run.Batches[j].CollectionDate
+		}
+		return run.Batches[i].SequenceType < run.Batches[j].SequenceType
+	})
+	for i := range run.Batches {
+		run.Batches[i].PaymentInformationId = paymentInformationId(run.MessageId, i)
+	}
+
+	if run.NumberOfTransactions > 0 {
+		file, err := newPain008(run, s.creditor, debits, now)
+		if err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+		if !run.DryRun {
+			run.FileName = run.MessageId + ".xml"
+			if _, err := s.blobs.Put(billingRunBlobKey(run), bytes.NewReader(file)); err != nil {
+				return Response(http.StatusInternalServerError, nil), err
+			}
+		}
+	}
+	if run.DryRun {
+		return Response(http.StatusOK, run), nil
+	}
+
+	for _, installment := range installments {
+		if created[installment.Id] {
+			err = s.repo.CreateInstallment(ctx, installment)
+		} else {
+			err = s.repo.UpdateInstallment(ctx, installment)
+		}
+		if err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
+	for contractId := range used {
+		if err := s.repo.UpdateMandate(ctx, mandates[contractId]); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
+	if err := s.repo.CreateBillingRun(ctx, run); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusCreated, run), nil
+}
+
+// DownloadBillingRunFile - Download the pain.008 file of a billing run
+func (s *BillingAPIService) DownloadBillingRunFile(ctx context.Context, billingRunId string) (ImplResponse, error) {
+	run, err := s.repo.GetBillingRun(ctx, billingRunId)
+	if err != nil {
+		return billingRunLookupError(billingRunId, err)
+	}
+	if run.FileName == "" {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("billing run %s collected no premiums and has no file", billingRunId)
+	}
+
+	file, err := s.blobs.Open(billingRunBlobKey(run))
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), fmt.Errorf("file of billing run %s: %w", billingRunId, err)
+	}
+
+	return Response(http.StatusOK, file), nil
+}
+
+// GetBillingRun - Get billing run details
+func (s *BillingAPIService) GetBillingRun(ctx context.Context, billingRunId string) (ImplResponse, error) {
+	run, err := s.repo.GetBillingRun(ctx, billingRunId)
+	if err != nil {
+		return billingRunLookupError(billingRunId, err)
+	}
+
+	return Response(http.StatusOK, run), nil
+}
+
+// GetBillingRuns - Get the billing runs, latest first
+func (s *BillingAPIService) GetBillingRuns(ctx context.Context, page int32, pageSize int32) (ImplResponse, error) {
+	runs, err := s.repo.ListBillingRuns(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].CreatedAt > runs[j].CreatedAt
+	})
+
+	return Response(http.StatusOK, paginate(runs, page, pageSize)), nil
+}
+
+// GetContractInstallments - Get the installments of a contract
+func (s *BillingAPIService) GetContractInstallments(ctx context.Context, contractId string, page int32, pageSize int32) (ImplResponse, error) {
+	if _, err := s.repo.GetContract(ctx, contractId); err != nil {
+		return contractLookupError(contractId, err)
+	}
+
+	installments, err := s.repo.ListContractInstallments(ctx, contractId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	sortInstallments(installments)
+
+	return Response(http.StatusOK, paginate(installments, page, pageSize)), nil
+}
+
+// GetContractInvoices - Get the invoices of a contract
+func (s *BillingAPIService) GetContractInvoices(ctx context.Context, contractId string, page int32, pageSize int32) (ImplResponse, error) {
+	contract, err := s.repo.GetContract(ctx, contractId)
+	if err != nil {
+		return contractLookupError(contractId, err)
+	}
+
+	installments, err := s.repo.ListContractInstallments(ctx, contractId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	invoices, err := contractInvoices(contract, installments)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, paginate(invoices, page, pageSize)), nil
+}
+
+// dueInstallments returns the open installments due until the given day, ordered by due date. Installments of
+// active contracts that fall due are created and reported in the returned set, they are not stored yet.
+func (s *BillingAPIService) dueInstallments(ctx context.Context, contracts map[string]ContractRes, until time.Time) ([]InstallmentRes, map[string]bool, error) {
+	stored, err := s.repo.ListInstallments(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	due := make([]InstallmentRes, 0)
+	existing := make(map[string]bool)
+	for _, installment := range stored {
+		existing[installment.ContractId+"/"+installment.DueDate] = true
+		if installment.Status == InstallmentStatusOpen && installment.DueDate <= until.Format(dateLayout) {
+			due = append(due, installment)
+		}
+	}
+
+	created := make(map[string]bool)
+	for _, contract := range contracts {
+		if contract.Status != ContractStatusActive || contract.Rate <= 0 {
+			continue
+		}
+		periods, err := premiumPeriods(contract, until)
+		if err != nil {
+			return nil, nil, fmt.Errorf("contract %s: %w", contract.Id, err)
+		}
+		amount, surcharge := contractInstallment(contract)
+		for _, period := range periods {
+			if existing[contract.Id+"/"+period.due.Format(dateLayout)] {
+				continue
+			}
+			installment := InstallmentRes{
+				Id:         newId(),
+				ContractId: contract.Id,
+				CustomerId: contract.CustomerId,
+				DueDate:    period.due.Format(dateLayout),
+				Amount:     amount,
+				Surcharge:  surcharge,
+				Status:     InstallmentStatusOpen,
+			}
+			due = append(due, installment)
+			created[installment.Id] = true
+		}
+	}
+	sortInstallments(due)
+
+	return due, created, nil
+}
+
+// activeMandates returns the mandate premiums are collected with on the given day by the id of its contract
+func (s *BillingAPIService) activeMandates(ctx context.Context, day time.Time) (map[string]MandateRes, error) {
+	mandates, err := s.repo.ListMandates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make(map[string]MandateRes)
+	for _, mandate := range mandates {
+		if mandate = withMandateExpiry(mandate, day); mandate.Status == MandateStatusActive {
+			active[mandate.ContractId] = mandate
+		}
+	}
+
+	return active, nil
+}
+
+// skippedInstallment reports an installment that is not collected
+func skippedInstallment(installment InstallmentRes, reason string) SkippedInstallment {
+	return SkippedInstallment{
+		InstallmentId: installment.Id,
+		ContractId:    installment.ContractId,
+		DueDate:       installment.DueDate,
+		Amount:        installment.Amount,
+		Reason:        reason,
+	}
+}
+
+// billingRunBlobKey returns the key the pain.008 file of the billing run is stored under in the blob store
+func billingRunBlobKey(run BillingRunRes) string {
+	return path.Join("billing-runs", run.Id, run.FileName)
+}
+
+// billingRunLookupError maps a repository error for the given billing run to a response
+func billingRunLookupError(billingRunId string, err error) (ImplResponse, error) {
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("billing run %s not found", billingRunId)
+	}
+
+	return Response(http.StatusInternalServerError, nil), err
+}
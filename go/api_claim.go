@@ -0,0 +1,506 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// ClaimAPIController binds http requests to an api service and writes the service results to the http response
+type ClaimAPIController struct {
+	service ClaimAPIServicer
+	errorHandler ErrorHandler
+}
+
+// ClaimAPIOption for how the controller is set up.
+type ClaimAPIOption func(*ClaimAPIController)
+
+// WithClaimAPIErrorHandler inject ErrorHandler into controller
+func WithClaimAPIErrorHandler(h ErrorHandler) ClaimAPIOption {
+	return func(c *ClaimAPIController) {
+		c.errorHandler = h
+	}
+}
+
+// NewClaimAPIController creates a default api controller
+func NewClaimAPIController(s ClaimAPIServicer, opts ...ClaimAPIOption) Router {
+	controller := &ClaimAPIController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the ClaimAPIController
+func (c *ClaimAPIController) Routes() Routes {
+	return Routes{
+		"AssignClaim": Route{
+			strings.ToUpper("Post"),
+			"/v1/claims/{claimId}/assign",
+			c.AssignClaim,
+		},
+		"CreateClaim": Route{
+			strings.ToUpper("Post"),
+			"/v1/contracts/{contractId}/claims",
+			c.CreateClaim,
+		},
+		"DecideClaim": Route{
+			strings.ToUpper("Post"),
+			"/v1/claims/{claimId}/decision",
+			c.DecideClaim,
+		},
+		"GetClaim": Route{
+			strings.ToUpper("Get"),
+			"/v1/claims/{claimId}",
+			c.GetClaim,
+		},
+		"GetClaimQueue": Route{
+			strings.ToUpper("Get"),
+			"/v1/claims/queue",
+			c.GetClaimQueue,
+		},
+		"GetContractClaims": Route{
+			strings.ToUpper("Get"),
+			"/v1/contracts/{contractId}/claims",
+			c.GetContractClaims,
+		},
+		"GetCustomerClaims": Route{
+			strings.ToUpper("Get"),
+			"/v1/customers/{customerId}/claims",
+			c.GetCustomerClaims,
+		},
+		"GetEmployeeClaims": Route{
+			strings.ToUpper("Get"),
+			"/v1/employees/{employeeId}/claims",
+			c.GetEmployeeClaims,
+		},
+		"PayClaim": Route{
+			strings.ToUpper("Post"),
+			"/v1/claims/{claimId}/pay",
+			c.PayClaim,
+		},
+		"PickClaim": Route{
+			strings.ToUpper("Post"),
+			"/v1/employees/{employeeId}/claims/next",
+			c.PickClaim,
+		},
+	}
+}
+
+// AssignClaim - Take over the review of a submitted claim
+func (c *ClaimAPIController) AssignClaim(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	claimIdParam := params["claimId"]
+	if claimIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"claimId"}, nil)
+		return
+	}
+	if err := validatePathUuid("claimId", claimIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	claimAssignmentReqParam := ClaimAssignmentReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&claimAssignmentReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertClaimAssignmentReqRequired(claimAssignmentReqParam), AssertClaimAssignmentReqConstraints(claimAssignmentReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.AssignClaim(r.Context(), claimIdParam, claimAssignmentReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CreateClaim - Submit a claim for a veterinary bill
+func (c *ClaimAPIController) CreateClaim(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	claimReqParam := ClaimReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&claimReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertClaimReqRequired(claimReqParam), AssertClaimReqConstraints(claimReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateClaim(r.Context(), contractIdParam, claimReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DecideClaim - Approve, partially approve or reject a claim in review
+func (c *ClaimAPIController) DecideClaim(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	claimIdParam := params["claimId"]
+	if claimIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"claimId"}, nil)
+		return
+	}
+	if err := validatePathUuid("claimId", claimIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	claimDecisionReqParam := ClaimDecisionReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&claimDecisionReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertClaimDecisionReqRequired(claimDecisionReqParam), AssertClaimDecisionReqConstraints(claimDecisionReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DecideClaim(r.Context(), claimIdParam, claimDecisionReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetClaim - Get claim details
+func (c *ClaimAPIController) GetClaim(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	claimIdParam := params["claimId"]
+	if claimIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"claimId"}, nil)
+		return
+	}
+	if err := validatePathUuid("claimId", claimIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetClaim(r.Context(), claimIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetClaimQueue - Get the submitted claims waiting for review
+func (c *ClaimAPIController) GetClaimQueue(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetClaimQueue(r.Context(), pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetContractClaims - Get the claims of a contract
+func (c *ClaimAPIController) GetContractClaims(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetContractClaims(r.Context(), contractIdParam, pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetCustomerClaims - Get the claims of all contracts of a customer
+func (c *ClaimAPIController) GetCustomerClaims(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	customerIdParam := params["customerId"]
+	if customerIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"customerId"}, nil)
+		return
+	}
+	if err := validatePathUuid("customerId", customerIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetCustomerClaims(r.Context(), customerIdParam, pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetEmployeeClaims - Get the claims assigned to an adjuster
+func (c *ClaimAPIController) GetEmployeeClaims(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	employeeIdParam := params["employeeId"]
+	if employeeIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"employeeId"}, nil)
+		return
+	}
+	if err := validatePathUuid("employeeId", employeeIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetEmployeeClaims(r.Context(), employeeIdParam, pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PayClaim - Record the payment of an approved claim
+func (c *ClaimAPIController) PayClaim(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	claimIdParam := params["claimId"]
+	if claimIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"claimId"}, nil)
+		return
+	}
+	if err := validatePathUuid("claimId", claimIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	claimPaymentReqParam := ClaimPaymentReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&claimPaymentReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertClaimPaymentReqRequired(claimPaymentReqParam), AssertClaimPaymentReqConstraints(claimPaymentReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PayClaim(r.Context(), claimIdParam, claimPaymentReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// PickClaim - Take over the review of the oldest claim in the queue
+func (c *ClaimAPIController) PickClaim(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	employeeIdParam := params["employeeId"]
+	if employeeIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"employeeId"}, nil)
+		return
+	}
+	if err := validatePathUuid("employeeId", employeeIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.PickClaim(r.Context(), employeeIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
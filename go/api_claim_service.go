@@ -0,0 +1,382 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ClaimAPIService is a service that implements the logic for the ClaimAPIServicer
+// This service should implement the business logic for every endpoint for the ClaimAPI API.
+// Include any external packages or services that will be required by this service.
+type ClaimAPIService struct {
+	repo   Repository
+	rates  *RateEngine
+	policy ClaimPolicy
+	now    func() time.Time
+	// mu serializes changes to claims, so two adjusters cannot pick the same claim
+	// and concurrent claims cannot exceed the coverage of a contract
+	mu sync.Mutex
+}
+
+// ClaimPolicy holds the business rules applied by the ClaimAPIService
+type ClaimPolicy struct {
+	// ReviewThreshold is the invoice amount above which a claim is reviewed by an adjuster instead of paid automatically
+	ReviewThreshold float32
+}
+
+// NewClaimAPIService creates a default api service
+func NewClaimAPIService(repo Repository, rates *RateEngine, policy ClaimPolicy) ClaimAPIServicer {
+	return &ClaimAPIService{
+		repo:   repo,
+		rates:  rates,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// AssignClaim - Take over the review of a submitted claim
+func (s *ClaimAPIService) AssignClaim(ctx context.Context, claimId string, claimAssignmentReq ClaimAssignmentReq) (ImplResponse, error) {
+	if result, err := s.checkEmployee(ctx, claimAssignmentReq.EmployeeId); err != nil {
+		return result, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.changeClaim(ctx, claimId, func(claim *ClaimRes) error {
+		return s.startReview(claim, claimAssignmentReq.EmployeeId)
+	})
+}
+
+// CreateClaim - Submit a claim for a veterinary bill
+func (s *ClaimAPIService) CreateClaim(ctx context.Context, contractId string, claimReq ClaimReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contract, err := s.repo.GetContract(ctx, contractId)
+	if err != nil {
+		return contractLookupError(contractId, err)
+	}
+	switch contract.Status {
+	case ContractStatusDraft:
+		return Response(http.StatusConflict, nil), fmt.Errorf("contract %s is a draft and does not cover treatments yet", contractId)
+	case ContractStatusSuspended:
+		return Response(http.StatusConflict, nil), fmt.Errorf("the cover of contract %s is suspended", contractId)
+	}
+
+	treatment, err := time.Parse(dateLayout, claimReq.TreatmentDate)
+	if err != nil {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("treatmentDate %q is not a valid date", claimReq.TreatmentDate)
+	}
+	if treatment.After(s.now()) {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("treatmentDate %s lies in the future", claimReq.TreatmentDate)
+	}
+	if err := checkCover(contract, treatment); err != nil {
+		return Response(http.StatusBadRequest, nil), err
+	}
+
+	tariff, err := s.rates.Tariff(contract.TariffVersion)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	yearStart, err := coverageYearStart(contract, treatment)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	others, err := s.repo.ListContractClaims(ctx, contractId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	var paid float32
+	for _, other := range others {
+		if other.CoverageYearStart == yearStart.Format(dateLayout) {
+			paid += other.PayableAmount
+		}
+	}
+
+	claim := ClaimRes{
+		Id:                newId(),
+		ContractId:        contract.Id,
+		CustomerId:        contract.CustomerId,
+		TreatmentDate:     claimReq.TreatmentDate,
+		Diagnosis:         claimReq.Diagnosis,
+		InvoiceAmount:     claimReq.InvoiceAmount,
+		VetPractice:       claimReq.VetPractice,
+		CreatedAt:         s.now().UTC().Format(time.RFC3339),
+		CoverageYearStart: yearStart.Format(dateLayout),
+	}
+	settleClaim(&claim, tariff, contract.Coverage, paid)
+
+	claim.Status = ClaimStatusSubmitted
+	claim.History = []ClaimTransition{{At: claim.CreatedAt, To: ClaimStatusSubmitted}}
+	claim.ReviewReasons = claimReviewReasons(claim, contract, others, s.policy.ReviewThreshold)
+	if len(claim.ReviewReasons) == 0 {
+		// The payout is recorded with PayClaim once it was transferred
+		if err := approveClaim(&claim, s.now()); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
+
+	if err := s.repo.CreateClaim(ctx, claim); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusCreated, claim), nil
+}
+
+// DecideClaim - Approve, partially approve or reject a claim in review
+func (s *ClaimAPIService) DecideClaim(ctx context.Context, claimId string, claimDecisionReq ClaimDecisionReq) (ImplResponse, error) {
+	if result, err := s.checkEmployee(ctx, claimDecisionReq.EmployeeId); err != nil {
+		return result, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.changeClaim(ctx, claimId, func(claim *ClaimRes) error {
+		if claim.Status != ClaimStatusInReview {
+			return fmt.Errorf("%w: claim %s is %s, only claims in review can be decided", ErrInvalidTransition, claim.Id, claim.Status)
+		}
+		if claim.AdjusterId != claimDecisionReq.EmployeeId {
+			return fmt.Errorf("%w: claim %s is reviewed by employee %s", ErrInvalidTransition, claim.Id, claim.AdjusterId)
+		}
+
+		payable := claim.PayableAmount
+		switch claimDecisionReq.Decision {
+		case ClaimStatusPartiallyApproved:
+			if claimDecisionReq.ApprovedAmount <= 0 || claimDecisionReq.ApprovedAmount >= claim.PayableAmount {
+				return fmt.Errorf("approvedAmount must lie between 0 and the payable amount of %.2f", claim.PayableAmount)
+			}
+			payable = claimDecisionReq.ApprovedAmount
+		case ClaimStatusApproved, ClaimStatusRejected:
+			if claimDecisionReq.ApprovedAmount != 0 {
+				return fmt.Errorf("approvedAmount is only allowed for the decision %s", ClaimStatusPartiallyApproved)
+			}
+			if claimDecisionReq.Decision == ClaimStatusRejected {
+				payable = 0
+			}
+		default:
+			return fmt.Errorf("decision must be one of %v", claimDecisions)
+		}
+
+		if err := transitionClaim(claim, claimDecisionReq.Decision, claimDecisionReq.EmployeeId, claimDecisionReq.Reason, s.now()); err != nil {
+			return err
+		}
+		claim.RemainingCoverage += claim.PayableAmount - payable
+		claim.PayableAmount = payable
+		claim.DecisionReason = claimDecisionReq.Reason
+
+		return nil
+	})
+}
+
+// GetClaim - Get claim details
+func (s *ClaimAPIService) GetClaim(ctx context.Context, claimId string) (ImplResponse, error) {
+	claim, err := s.repo.GetClaim(ctx, claimId)
+	if err != nil {
+		return claimLookupError(claimId, err)
+	}
+
+	return Response(http.StatusOK, claim), nil
+}
+
+// GetClaimQueue - Get the submitted claims waiting for review
+func (s *ClaimAPIService) GetClaimQueue(ctx context.Context, page int32, pageSize int32) (ImplResponse, error) {
+	queue, err := s.reviewQueue(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, paginate(queue, page, pageSize)), nil
+}
+
+// GetContractClaims - Get the claims of a contract
+func (s *ClaimAPIService) GetContractClaims(ctx context.Context, contractId string, page int32, pageSize int32) (ImplResponse, error) {
+	if _, err := s.repo.GetContract(ctx, contractId); err != nil {
+		return contractLookupError(contractId, err)
+	}
+
+	claims, err := s.repo.ListContractClaims(ctx, contractId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, paginate(claims, page, pageSize)), nil
+}
+
+// GetCustomerClaims - Get the claims of all contracts of a customer
+func (s *ClaimAPIService) GetCustomerClaims(ctx context.Context, customerId string, page int32, pageSize int32) (ImplResponse, error) {
+	if _, err := s.repo.GetCustomer(ctx, customerId); err != nil {
+		return customerLookupError(customerId, err)
+	}
+
+	claims, err := s.repo.ListCustomerClaims(ctx, customerId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, paginate(claims, page, pageSize)), nil
+}
+
+// GetEmployeeClaims - Get the claims assigned to an adjuster
+func (s *ClaimAPIService) GetEmployeeClaims(ctx context.Context, employeeId string, page int32, pageSize int32) (ImplResponse, error) {
+	if _, err := s.repo.GetEmployee(ctx, employeeId); err != nil {
+		return employeeLookupError(employeeId, err)
+	}
+
+	claims, err := s.repo.ListClaims(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	assigned := make([]ClaimRes, 0)
+	for _, claim := range claims {
+		if claim.AdjusterId == employeeId {
+			assigned = append(assigned, claim)
+		}
+	}
+
+	return Response(http.StatusOK, paginate(assigned, page, pageSize)), nil
+}
+
+// PayClaim - Record the payment of an approved claim
+func (s *ClaimAPIService) PayClaim(ctx context.Context, claimId string, claimPaymentReq ClaimPaymentReq) (ImplResponse, error) {
+	if result, err := s.checkEmployee(ctx, claimPaymentReq.EmployeeId); err != nil {
+		return result, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.changeClaim(ctx, claimId, func(claim *ClaimRes) error {
+		now := s.now()
+		if err := transitionClaim(claim, ClaimStatusPaid, claimPaymentReq.EmployeeId, "", now); err != nil {
+			return err
+		}
+		claim.PaidAt = now.UTC().Format(time.RFC3339)
+		claim.PaymentReference = claimPaymentReq.PaymentReference
+
+		return nil
+	})
+}
+
+// PickClaim - Take over the review of the oldest claim in the queue
+func (s *ClaimAPIService) PickClaim(ctx context.Context, employeeId string) (ImplResponse, error) {
+	if _, err := s.repo.GetEmployee(ctx, employeeId); err != nil {
+		return employeeLookupError(employeeId, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	queue, err := s.reviewQueue(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if len(queue) == 0 {
+		return Response(http.StatusNoContent, nil), nil
+	}
+
+	return s.changeClaim(ctx, queue[0].Id, func(claim *ClaimRes) error {
+		return s.startReview(claim, employeeId)
+	})
+}
+
+// changeClaim applies change to the stored claim. Invalid status transitions are answered with 409, other errors with 400.
+func (s *ClaimAPIService) changeClaim(ctx context.Context, claimId string, change func(claim *ClaimRes) error) (ImplResponse, error) {
+	claim, err := s.repo.GetClaim(ctx, claimId)
+	if err != nil {
+		return claimLookupError(claimId, err)
+	}
+
+	if err := change(&claim); err != nil {
+		if errors.Is(err, ErrInvalidTransition) {
+			return Response(http.StatusConflict, nil), err
+		}
+		return Response(http.StatusBadRequest, nil), err
+	}
+
+	if err := s.repo.UpdateClaim(ctx, claim); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, claim), nil
+}
+
+// startReview assigns the claim to the adjuster and puts it in review
+func (s *ClaimAPIService) startReview(claim *ClaimRes, employeeId string) error {
+	if err := transitionClaim(claim, ClaimStatusInReview, employeeId, "", s.now()); err != nil {
+		return err
+	}
+	claim.AdjusterId = employeeId
+
+	return nil
+}
+
+// reviewQueue returns the submitted claims, oldest first
+func (s *ClaimAPIService) reviewQueue(ctx context.Context) ([]ClaimRes, error) {
+	claims, err := s.repo.ListClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([]ClaimRes, 0)
+	for _, claim := range claims {
+		if claim.Status == ClaimStatusSubmitted {
+			queue = append(queue, claim)
+		}
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		return queue[i].CreatedAt < queue[j].CreatedAt
+	})
+
+	return queue, nil
+}
+
+// checkEmployee returns an error if the employee referenced by a request does not exist, or if the caller may not
+// act as it. Callers acting as an employee may only reference themselves; only admins act on behalf of others.
+func (s *ClaimAPIService) checkEmployee(ctx context.Context, employeeId string) (ImplResponse, error) {
+	if principal, ok := PrincipalFrom(ctx); ok {
+		if principal.EmployeeId != "" && principal.EmployeeId != employeeId {
+			return Response(http.StatusForbidden, nil), fmt.Errorf("%s acts as employee %s and cannot act as employee %s", principal.Subject, principal.EmployeeId, employeeId)
+		}
+		if principal.EmployeeId == "" && !principal.HasRole(RoleAdmin) {
+			return Response(http.StatusForbidden, nil), fmt.Errorf("%s does not act as an employee", principal.Subject)
+		}
+	}
+
+	employee, err := s.repo.GetEmployee(ctx, employeeId)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return Response(http.StatusBadRequest, nil), fmt.Errorf("employee %s does not exist", employeeId)
+		}
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if employee.Status == EmployeeStatusInactive {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("employee %s is deactivated", employeeId)
+	}
+
+	return ImplResponse{}, nil
+}
+
+// claimLookupError maps a repository error for the given claim to a response
+func claimLookupError(claimId string, err error) (ImplResponse, error) {
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("claim %s not found", claimId)
+	}
+
+	return Response(http.StatusInternalServerError, nil), err
+}
@@ -50,31 +50,145 @@ func NewContractAPIController(s ContractAPIServicer, opts ...ContractAPIOption)
 // Routes returns all the api routes for the ContractAPIController
 func (c *ContractAPIController) Routes() Routes {
 	return Routes{
+		"ActivateContract": Route{
+			strings.ToUpper("Post"),
+			"/v1/contracts/{contractId}/activate",
+			c.ActivateContract,
+		},
+		"AmendContract": Route{
+			strings.ToUpper("Patch"),
+			"/v1/contracts/{contractId}",
+			c.AmendContract,
+		},
 		"CalculateRate": Route{
 			strings.ToUpper("Post"),
 			"/v1/contracts/rate",
 			c.CalculateRate,
 		},
+		"CancelContract": Route{
+			strings.ToUpper("Post"),
+			"/v1/contracts/{contractId}/cancel",
+			c.CancelContract,
+		},
 		"CreateContract": Route{
 			strings.ToUpper("Post"),
 			"/v1/contracts",
 			c.CreateContract,
 		},
+		"ExplainRate": Route{
+			strings.ToUpper("Post"),
+			"/v1/contracts/rate/explain",
+			c.ExplainRate,
+		},
 		"GetContract": Route{
 			strings.ToUpper("Get"),
 			"/v1/contracts/{contractId}",
 			c.GetContract,
 		},
+		"GetContractVersions": Route{
+			strings.ToUpper("Get"),
+			"/v1/contracts/{contractId}/versions",
+			c.GetContractVersions,
+		},
+		"GetQuote": Route{
+			strings.ToUpper("Get"),
+			"/v1/quotes/{quoteId}",
+			c.GetQuote,
+		},
 		"GetCustomerContracts": Route{
 			strings.ToUpper("Get"),
 			"/v1/customers/{customerId}/contracts",
 			c.GetCustomerContracts,
 		},
+		"ReinstateContract": Route{
+			strings.ToUpper("Post"),
+			"/v1/contracts/{contractId}/reinstate",
+			c.ReinstateContract,
+		},
+		"SuspendContract": Route{
+			strings.ToUpper("Post"),
+			"/v1/contracts/{contractId}/suspend",
+			c.SuspendContract,
+		},
 	}
 }
 
+// ActivateContract - Activate a draft contract
+func (c *ContractAPIController) ActivateContract(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ActivateContract(r.Context(), contractIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// AmendContract - Amend a contract
+func (c *ContractAPIController) AmendContract(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	contractAmendmentReqParam := ContractAmendmentReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&contractAmendmentReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertContractAmendmentReqRequired(contractAmendmentReqParam), AssertContractAmendmentReqConstraints(contractAmendmentReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.AmendContract(r.Context(), contractIdParam, contractAmendmentReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // CalculateRate - Calculate rate
 func (c *ContractAPIController) CalculateRate(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var saveQuoteParam bool
+	if query.Has("saveQuote") {
+		param, err := parseBoolParameter(
+			query.Get("saveQuote"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "saveQuote", Err: err}, nil)
+			return
+		}
+
+		saveQuoteParam = param
+	} else {
+	}
 	rateCalculationReqParam := RateCalculationReq{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -82,15 +196,44 @@ func (c *ContractAPIController) CalculateRate(w http.ResponseWriter, r *http.Req
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
-	if err := AssertRateCalculationReqRequired(rateCalculationReqParam); err != nil {
+	if err := validateAll(AssertRateCalculationReqRequired(rateCalculationReqParam), AssertRateCalculationReqConstraints(rateCalculationReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CalculateRate(r.Context(), rateCalculationReqParam, saveQuoteParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CancelContract - Cancel a contract
+func (c *ContractAPIController) CancelContract(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	if err := AssertRateCalculationReqConstraints(rateCalculationReqParam); err != nil {
+	contractCancellationReqParam := ContractCancellationReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&contractCancellationReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertContractCancellationReqRequired(contractCancellationReqParam), AssertContractCancellationReqConstraints(contractCancellationReqParam)); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.CalculateRate(r.Context(), rateCalculationReqParam)
+	result, err := c.service.CancelContract(r.Context(), contractIdParam, contractCancellationReqParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -109,15 +252,34 @@ func (c *ContractAPIController) CreateContract(w http.ResponseWriter, r *http.Re
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
-	if err := AssertContractReqRequired(contractReqParam); err != nil {
+	if err := validateAll(AssertContractReqRequired(contractReqParam), AssertContractReqConstraints(contractReqParam)); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	if err := AssertContractReqConstraints(contractReqParam); err != nil {
+	result, err := c.service.CreateContract(r.Context(), contractReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ExplainRate - Explain the calculation of a rate
+func (c *ContractAPIController) ExplainRate(w http.ResponseWriter, r *http.Request) {
+	rateCalculationReqParam := RateCalculationReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&rateCalculationReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertRateCalculationReqRequired(rateCalculationReqParam), AssertRateCalculationReqConstraints(rateCalculationReqParam)); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.CreateContract(r.Context(), contractReqParam)
+	result, err := c.service.ExplainRate(r.Context(), rateCalculationReqParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -135,6 +297,10 @@ func (c *ContractAPIController) GetContract(w http.ResponseWriter, r *http.Reque
 		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
 		return
 	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
 	result, err := c.service.GetContract(r.Context(), contractIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
@@ -145,6 +311,28 @@ func (c *ContractAPIController) GetContract(w http.ResponseWriter, r *http.Reque
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetContractVersions - Get the version history of a contract
+func (c *ContractAPIController) GetContractVersions(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetContractVersions(r.Context(), contractIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetCustomerContracts - Get customer contracts
 func (c *ContractAPIController) GetCustomerContracts(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -158,6 +346,10 @@ func (c *ContractAPIController) GetCustomerContracts(w http.ResponseWriter, r *h
 		c.errorHandler(w, r, &RequiredError{"customerId"}, nil)
 		return
 	}
+	if err := validatePathUuid("customerId", customerIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
 	var pageParam int32
 	if query.Has("page") {
 		param, err := parseNumericParameter[int32](
@@ -167,7 +359,7 @@ func (c *ContractAPIController) GetCustomerContracts(w http.ResponseWriter, r *h
 			WithMaximum[int32](100),
 		)
 		if err != nil {
-			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
 			return
 		}
 
@@ -183,14 +375,91 @@ func (c *ContractAPIController) GetCustomerContracts(w http.ResponseWriter, r *h
 			WithMaximum[int32](100),
 		)
 		if err != nil {
-			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
 			return
 		}
 
 		pageSizeParam = param
 	} else {
 	}
-	result, err := c.service.GetCustomerContracts(r.Context(), customerIdParam, pageParam, pageSizeParam)
+	var statusParam ContractStatus
+	if query.Has("status") {
+		param, err := NewContractStatusFromValue(query.Get("status"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "status", Err: err}, nil)
+			return
+		}
+
+		statusParam = param
+	} else {
+	}
+	result, err := c.service.GetCustomerContracts(r.Context(), customerIdParam, pageParam, pageSizeParam, statusParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetQuote - Get a saved quote
+func (c *ContractAPIController) GetQuote(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	quoteIdParam := params["quoteId"]
+	if quoteIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"quoteId"}, nil)
+		return
+	}
+	if err := validatePathUuid("quoteId", quoteIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetQuote(r.Context(), quoteIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ReinstateContract - Reinstate a suspended or cancelled contract
+func (c *ContractAPIController) ReinstateContract(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ReinstateContract(r.Context(), contractIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// SuspendContract - Suspend a contract
+func (c *ContractAPIController) SuspendContract(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.SuspendContract(r.Context(), contractIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -11,64 +11,623 @@ package openapi
 
 import (
 	"context"
-	"net/http"
 	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
 // ContractAPIService is a service that implements the logic for the ContractAPIServicer
 // This service should implement the business logic for every endpoint for the ContractAPI API.
 // Include any external packages or services that will be required by this service.
 type ContractAPIService struct {
+	repo   Repository
+	rates  *RateEngine
+	policy ContractPolicy
+	now    func() time.Time
+	// mu serializes the changes of contracts, so a quote cannot be used for two contracts. It is shared with the
+	// ContractScheduler and the DunningScheduler, so that no change is overwritten by a renewal, expiry or
+	// suspension working on an older state of the contract.
+	mu *sync.Mutex
 }
 
-// NewContractAPIService creates a default api service
-func NewContractAPIService() ContractAPIServicer {
-	return &ContractAPIService{}
+// ContractPolicy holds the business rules applied by the ContractAPIService
+type ContractPolicy struct {
+	// QuoteValidity is how long a saved quote can be referenced by new contracts
+	QuoteValidity time.Duration
+	// CancellationNotice is the minimum time between cancelling an active or suspended contract and the cancellation taking effect
+	CancellationNotice time.Duration
+}
+
+// NewContractAPIService creates a default api service. contracts is the lock the ContractScheduler and the
+// DunningScheduler change contracts under.
+func NewContractAPIService(repo Repository, rates *RateEngine, policy ContractPolicy, contracts *sync.Mutex) ContractAPIServicer {
+	return &ContractAPIService{
+		repo:   repo,
+		rates:  rates,
+		policy: policy,
+		now:    time.Now,
+		mu:     contracts,
+	}
 }
 
 // CalculateRate - Calculate rate
-func (s *ContractAPIService) CalculateRate(ctx context.Context, rateCalculationReq RateCalculationReq) (ImplResponse, error) {
-	// TODO - update CalculateRate with the required logic for this service method.
-	// Add api_contract_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+func (s *ContractAPIService) CalculateRate(ctx context.Context, rateCalculationReq RateCalculationReq, saveQuote bool) (ImplResponse, error) {
+	calculation, err := s.rates.Calculate(rateCalculationReq)
+	if err != nil {
+		return Response(http.StatusBadRequest, nil), err
+	}
+	rate := RateRes{Rate: float32(calculation.Rate), Installments: calculation.Installments}
+	if !saveQuote {
+		return Response(http.StatusOK, rate), nil
+	}
+
+	now := s.now().UTC()
+	quote := QuoteRes{
+		Id:            newId(),
+		Rate:          rate.Rate,
+		TariffVersion: calculation.TariffVersion,
+		CreatedAt:     now.Format(time.RFC3339),
+		ValidUntil:    now.Add(s.policy.QuoteValidity).Format(time.RFC3339),
+		Request:       rateCalculationReq,
+	}
+	if err := s.repo.CreateQuote(ctx, quote); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	rate.QuoteId = quote.Id
+	rate.ValidUntil = quote.ValidUntil
 
-	// TODO: Uncomment the next line to return response Response(200, RateRes{}) or use other options such as http.Ok ...
-	// return Response(200, RateRes{}), nil
+	return Response(http.StatusOK, rate), nil
+}
+
+// ExplainRate - Explain the calculation of a rate
+func (s *ContractAPIService) ExplainRate(ctx context.Context, rateCalculationReq RateCalculationReq) (ImplResponse, error) {
+	calculation, err := s.rates.Calculate(rateCalculationReq)
+	if err != nil {
+		return Response(http.StatusBadRequest, nil), err
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("CalculateRate method not implemented")
+	return Response(http.StatusOK, RateExplanationRes{
+		TariffVersion:   calculation.TariffVersion,
+		BaseRate:        float32(calculation.BaseRate),
+		CoveragePremium: float32(calculation.CoveragePremium),
+		BasePremium:     float32(calculation.BasePremium),
+		Factors:         calculation.Factors,
+		UncappedRate:    float32(calculation.UncappedRate),
+		MinimumRate:     float32(calculation.MinimumRate),
+		MaximumRate:     float32(calculation.MaximumRate),
+		CapApplied:      calculation.Cap,
+		Rate:            float32(calculation.Rate),
+		Installments:    calculation.Installments,
+	}), nil
 }
 
 // CreateContract - Create a new contract
 func (s *ContractAPIService) CreateContract(ctx context.Context, contractReq ContractReq) (ImplResponse, error) {
-	// TODO - update CreateContract with the required logic for this service method.
-	// Add api_contract_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	customer, err := s.repo.GetCustomer(ctx, contractReq.CustomerId)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return Response(http.StatusBadRequest, nil), fmt.Errorf("customer %s does not exist", contractReq.CustomerId)
+		}
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	contract := ContractRes{
+		Id:               newId(),
+		StartDate:        contractReq.StartDate,
+		EndDate:          contractReq.EndDate,
+		Coverage:         contractReq.Coverage,
+		CatName:          contractReq.CatName,
+		Breed:            contractReq.Breed,
+		Color:            contractReq.Color,
+		BirthDate:        contractReq.BirthDate,
+		Neutered:         contractReq.Neutered,
+		Personality:      contractReq.Personality,
+		Environment:      contractReq.Environment,
+		Weight:           contractReq.Weight,
+		CustomerId:       contractReq.CustomerId,
+		QuoteId:          contractReq.QuoteId,
+		AutoRenew:        contractReq.AutoRenew,
+		Status:           ContractStatusDraft,
+		Version:          1,
+		PaymentFrequency: contractReq.PaymentFrequency,
+	}
+
+	rateReq := rateRequestFor(contract, customer)
+	var quote QuoteRes
+	if contractReq.QuoteId != "" {
+		var result ImplResponse
+		quote, result, err = s.quoteForContract(ctx, contractReq.QuoteId, rateReq)
+		if err != nil {
+			return result, err
+		}
+	} else {
+		calculation, err := s.rates.Calculate(rateReq)
+		if err != nil {
+			return Response(http.StatusBadRequest, nil), err
+		}
+		quote.Rate = float32(calculation.Rate)
+		quote.TariffVersion = calculation.TariffVersion
+	}
+	contract.Rate = quote.Rate
+	contract.TariffVersion = quote.TariffVersion
+	if err := priceInstallments(&contract, s.rates); err != nil {
+		return Response(http.StatusBadRequest, nil), err
+	}
+	contract.Created = auditStamp(ctx, s.now())
+	contract.Modified = contract.Created
 
-	// TODO: Uncomment the next line to return response Response(201, ContractRes{}) or use other options such as http.Ok ...
-	// return Response(201, ContractRes{}), nil
+	if err := s.repo.CreateContract(ctx, contract); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if err := s.repo.CreateContractVersion(ctx, contractVersionOf(contract, customer.Address.ZipCode, contract.StartDate, s.now())); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if quote.Id != "" {
+		quote.ContractId = contract.Id
+		if err := s.repo.UpdateQuote(ctx, quote); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
 
-	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
-	// return Response(400, nil),nil
+	return Response(http.StatusCreated, contract), nil
+}
+
+// AmendContract - Amend a contract
+func (s *ContractAPIService) AmendContract(ctx context.Context, contractId string, contractAmendmentReq ContractAmendmentReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contract, err := s.repo.GetContract(ctx, contractId)
+	if err != nil {
+		return contractLookupError(contractId, err)
+	}
+	if contract.Status == ContractStatusCancelled || contract.Status == ContractStatusExpired {
+		return Response(http.StatusConflict, nil), fmt.Errorf("contract %s is %s and cannot be amended", contractId, contract.Status)
+	}
+	customer, err := s.repo.GetCustomer(ctx, contract.CustomerId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	versions, err := s.contractVersions(ctx, contract, customer)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	latest := versions[len(versions)-1]
+
+	effective, err := time.Parse(dateLayout, contractAmendmentReq.EffectiveDate)
+	if err != nil {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("effectiveDate %q is not a valid date", contractAmendmentReq.EffectiveDate)
+	}
+	start, err := termStart(contract)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	end, err := time.Parse(dateLayout, contract.EndDate)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if effective.Before(start) || effective.After(end) {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("effectiveDate %s lies outside of the current term from %s to %s",
+			contractAmendmentReq.EffectiveDate, start.Format(dateLayout), contract.EndDate)
+	}
+	if contractAmendmentReq.EffectiveDate < latest.EffectiveDate {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("effectiveDate %s lies before version %d of contract %s, effective from %s",
+			contractAmendmentReq.EffectiveDate, latest.Version, contractId, latest.EffectiveDate)
+	}
+
+	amended := contract
+	previous := customer
+	if err := applyAmendment(&amended, &customer, contractAmendmentReq); err != nil {
+		return Response(http.StatusBadRequest, nil), err
+	}
+	version, err := s.repriceContract(contract, &amended, customer, latest.Version, start, effective, end)
+	if err != nil {
+		return Response(http.StatusBadRequest, nil), err
+	}
+	amended.Modified = auditStamp(ctx, s.now())
+
+	// The address determines the region of every contract of the customer, so the others are repriced as well
+	contracts := []ContractRes{amended}
+	versions = []ContractVersion{version}
+	if contractAmendmentReq.Address != nil {
+		others, otherVersions, err := s.relocateContracts(ctx, contract, previous, customer, effective)
+		if err != nil {
+			return Response(http.StatusBadRequest, nil), err
+		}
+		for i := range others {
+			others[i].Modified = amended.Modified
+		}
+		contracts = append(contracts, others...)
+		versions = append(versions, otherVersions...)
+
+		customer.Modified = amended.Modified
+		if err := s.repo.UpdateCustomer(ctx, customer); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
+	for i := range contracts {
+		if err := s.repo.CreateContractVersion(ctx, versions[i]); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+		if err := s.repo.UpdateContract(ctx, contracts[i]); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
+
+	return Response(http.StatusOK, amended), nil
+}
+
+// repriceContract calculates the rate of the amended contract from effective on with the tariff version of
+// contract, and returns the new version recording the pro rata premium difference for the rest of the term
+func (s *ContractAPIService) repriceContract(contract ContractRes, amended *ContractRes, customer CustomerRes, latestVersion int32, start time.Time, effective time.Time, end time.Time) (ContractVersion, error) {
+	calculation, err := s.rates.CalculateVersion(rateRequestFor(*amended, customer), contract.TariffVersion, effective)
+	if err != nil {
+		return ContractVersion{}, fmt.Errorf("contract %s: %w", contract.Id, err)
+	}
+	amended.Rate = float32(calculation.Rate)
+	amended.Version = latestVersion + 1
+	if err := priceInstallments(amended, s.rates); err != nil {
+		return ContractVersion{}, fmt.Errorf("contract %s: %w", contract.Id, err)
+	}
+
+	version := contractVersionOf(*amended, customer.Address.ZipCode, effective.Format(dateLayout), s.now())
+	version.PremiumAdjustment = proRataAdjustment(contract.Rate, amended.Rate, start, effective, end)
+
+	return version, nil
+}
+
+// relocateContracts reprices the other draft, active and suspended contracts of the customer, whose address
+// changes from previous to customer's from effective on. A contract is repriced from the start of its current
+// term if that lies later, and not before its latest version. Contracts whose term ends before effective are
+// repriced by their renewal.
+func (s *ContractAPIService) relocateContracts(ctx context.Context, amended ContractRes, previous CustomerRes, customer CustomerRes, effective time.Time) ([]ContractRes, []ContractVersion, error) {
+	contracts, err := s.repo.ListCustomerContracts(ctx, customer.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	relocated := make([]ContractRes, 0)
+	versions := make([]ContractVersion, 0)
+	for _, contract := range contracts {
+		if contract.Id == amended.Id || contract.Status == ContractStatusCancelled || contract.Status == ContractStatusExpired {
+			continue
+		}
+		start, err := termStart(contract)
+		if err != nil {
+			return nil, nil, err
+		}
+		end, err := time.Parse(dateLayout, contract.EndDate)
+		if err != nil {
+			return nil, nil, err
+		}
+		if effective.After(end) {
+			continue
+		}
+		existing, err := s.contractVersions(ctx, contract, previous)
+		if err != nil {
+			return nil, nil, err
+		}
+		latest := existing[len(existing)-1]
+
+		from := effective
+		if start.After(from) {
+			from = start
+		}
+		if latestEffective, err := time.Parse(dateLayout, latest.EffectiveDate); err == nil && latestEffective.After(from) {
+			from = latestEffective
+		}
+
+		repriced := contract
+		version, err := s.repriceContract(contract, &repriced, customer, latest.Version, start, from, end)
+		if err != nil {
+			return nil, nil, err
+		}
+		relocated = append(relocated, repriced)
+		versions = append(versions, version)
+	}
+
+	return relocated, versions, nil
+}
+
+// GetContractVersions - Get the version history of a contract
+func (s *ContractAPIService) GetContractVersions(ctx context.Context, contractId string) (ImplResponse, error) {
+	contract, err := s.repo.GetContract(ctx, contractId)
+	if err != nil {
+		return contractLookupError(contractId, err)
+	}
+	customer, err := s.repo.GetCustomer(ctx, contract.CustomerId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	versions, err := s.contractVersions(ctx, contract, customer)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, versions), nil
+}
+
+// ActivateContract - Activate a draft contract
+func (s *ContractAPIService) ActivateContract(ctx context.Context, contractId string) (ImplResponse, error) {
+	return s.changeContractStatus(ctx, contractId, func(contract *ContractRes) error {
+		if contract.Status != ContractStatusDraft {
+			return fmt.Errorf("%w: only draft contracts can be activated, contract %s is %s", ErrInvalidTransition, contract.Id, contract.Status)
+		}
+		if contract.EndDate < s.now().Format(dateLayout) {
+			return fmt.Errorf("%w: the term of contract %s ended on %s", ErrInvalidTransition, contract.Id, contract.EndDate)
+		}
+		return transitionContract(contract, ContractStatusActive)
+	})
+}
+
+// SuspendContract - Suspend the cover of an active contract
+func (s *ContractAPIService) SuspendContract(ctx context.Context, contractId string) (ImplResponse, error) {
+	return s.changeContractStatus(ctx, contractId, func(contract *ContractRes) error {
+		return transitionContract(contract, ContractStatusSuspended)
+	})
+}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("CreateContract method not implemented")
+// CancelContract - Cancel a contract
+func (s *ContractAPIService) CancelContract(ctx context.Context, contractId string, contractCancellationReq ContractCancellationReq) (ImplResponse, error) {
+	return s.changeContractStatus(ctx, contractId, func(contract *ContractRes) error {
+		effectiveDate, err := s.cancellationDate(*contract, contractCancellationReq.EffectiveDate)
+		if err != nil {
+			return err
+		}
+		previous := contract.Status
+		if err := transitionContract(contract, ContractStatusCancelled); err != nil {
+			return err
+		}
+		contract.StatusBeforeCancellation = previous
+		contract.CancellationReason = contractCancellationReq.Reason
+		contract.CancellationDate = effectiveDate
+		return nil
+	})
+}
+
+// ReinstateContract - Reinstate a suspended contract or revert a cancellation that has not taken effect yet
+// Reverting a cancellation restores the status the contract had before, e.g. a draft stays a draft and a cover
+// suspended by dunning stays suspended.
+func (s *ContractAPIService) ReinstateContract(ctx context.Context, contractId string) (ImplResponse, error) {
+	return s.changeContractStatus(ctx, contractId, func(contract *ContractRes) error {
+		to := ContractStatusActive
+		switch contract.Status {
+		case ContractStatusSuspended:
+		case ContractStatusCancelled:
+			if contract.CancellationDate <= s.now().Format(dateLayout) {
+				return fmt.Errorf("%w: the cancellation of contract %s took effect on %s", ErrInvalidTransition, contract.Id, contract.CancellationDate)
+			}
+			// Contracts cancelled before the previous status was recorded were active
+			if contract.StatusBeforeCancellation != "" {
+				to = contract.StatusBeforeCancellation
+			}
+		default:
+			return fmt.Errorf("%w: only suspended or cancelled contracts can be reinstated, contract %s is %s", ErrInvalidTransition, contract.Id, contract.Status)
+		}
+		if err := transitionContract(contract, to); err != nil {
+			return err
+		}
+		contract.CancellationReason = ""
+		contract.CancellationDate = ""
+		contract.StatusBeforeCancellation = ""
+		return nil
+	})
 }
 
 // GetContract - 
 func (s *ContractAPIService) GetContract(ctx context.Context, contractId string) (ImplResponse, error) {
-	// TODO - update GetContract with the required logic for this service method.
-	// Add api_contract_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
-
-	// TODO: Uncomment the next line to return response Response(200, ContractRes{}) or use other options such as http.Ok ...
-	// return Response(200, ContractRes{}), nil
+	contract, err := s.repo.GetContract(ctx, contractId)
+	if err != nil {
+		return contractLookupError(contractId, err)
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("GetContract method not implemented")
+	return Response(http.StatusOK, contract), nil
 }
 
 // GetCustomerContracts - Get customer contracts
-func (s *ContractAPIService) GetCustomerContracts(ctx context.Context, customerId string, page int32, pageSize int32) (ImplResponse, error) {
-	// TODO - update GetCustomerContracts with the required logic for this service method.
-	// Add api_contract_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+func (s *ContractAPIService) GetCustomerContracts(ctx context.Context, customerId string, page int32, pageSize int32, status ContractStatus) (ImplResponse, error) {
+	if _, err := s.repo.GetCustomer(ctx, customerId); err != nil {
+		return customerLookupError(customerId, err)
+	}
+
+	contracts, err := s.repo.ListCustomerContracts(ctx, customerId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, paginate(filterContracts(contracts, status), page, pageSize)), nil
+}
+
+// GetQuote - Get a saved quote
+func (s *ContractAPIService) GetQuote(ctx context.Context, quoteId string) (ImplResponse, error) {
+	quote, err := s.repo.GetQuote(ctx, quoteId)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return Response(http.StatusNotFound, nil), fmt.Errorf("quote %s not found", quoteId)
+		}
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, quote), nil
+}
+
+// contractVersions returns the versions of a contract, oldest first. Contracts created before versions
+// were recorded get their current state stored as version 1.
+func (s *ContractAPIService) contractVersions(ctx context.Context, contract ContractRes, customer CustomerRes) ([]ContractVersion, error) {
+	versions, err := s.repo.ListContractVersions(ctx, contract.Id)
+	if err != nil || len(versions) > 0 {
+		return versions, err
+	}
+
+	contract.Version = 1
+	initial := contractVersionOf(contract, customer.Address.ZipCode, contract.StartDate, s.now())
+	if err := s.repo.CreateContractVersion(ctx, initial); err != nil {
+		return nil, err
+	}
+
+	return []ContractVersion{initial}, nil
+}
+
+// changeContractStatus loads a contract, applies change to it and stores the result.
+// Errors wrapping ErrInvalidTransition are reported as conflicts, all other errors of change as bad requests.
+func (s *ContractAPIService) changeContractStatus(ctx context.Context, contractId string, change func(contract *ContractRes) error) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contract, err := s.repo.GetContract(ctx, contractId)
+	if err != nil {
+		return contractLookupError(contractId, err)
+	}
+
+	if err := change(&contract); err != nil {
+		if errors.Is(err, ErrInvalidTransition) {
+			return Response(http.StatusConflict, nil), err
+		}
+		return Response(http.StatusBadRequest, nil), err
+	}
+	contract.Modified = auditStamp(ctx, s.now())
+
+	if err := s.repo.UpdateContract(ctx, contract); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, contract), nil
+}
+
+// cancellationDate determines the date a cancellation of the contract takes effect. Draft contracts can be
+// cancelled from today on, all others only after the notice period. Without a requested date the earliest
+// possible one is used, but never a date after the end of the contract.
+func (s *ContractAPIService) cancellationDate(contract ContractRes, requested string) (string, error) {
+	now := s.now()
+	earliest := now.Format(dateLayout)
+	if contract.Status != ContractStatusDraft {
+		earliest = now.Add(s.policy.CancellationNotice).Format(dateLayout)
+	}
+
+	if requested == "" {
+		if earliest > contract.EndDate {
+			return contract.EndDate, nil
+		}
+		return earliest, nil
+	}
+	if _, err := time.Parse(dateLayout, requested); err != nil {
+		return "", fmt.Errorf("effectiveDate %q is not a valid date", requested)
+	}
+	if requested < earliest {
+		return "", fmt.Errorf("the cancellation of contract %s can take effect on %s at the earliest", contract.Id, earliest)
+	}
+	if requested > contract.EndDate {
+		return "", fmt.Errorf("effectiveDate %s lies after the end of contract %s on %s", requested, contract.Id, contract.EndDate)
+	}
+
+	return requested, nil
+}
+
+// filterContracts returns the contracts in the given status, or all contracts if status is empty
+func filterContracts(contracts []ContractRes, status ContractStatus) []ContractRes {
+	if status == "" {
+		return contracts
+	}
+
+	filtered := make([]ContractRes, 0)
+	for _, contract := range contracts {
+		if contract.Status == status {
+			filtered = append(filtered, contract)
+		}
+	}
+
+	return filtered
+}
+
+// quoteForContract loads the quote referenced by a contract request and checks that it is still valid,
+// has not been used by another contract and was calculated for the same cat
+func (s *ContractAPIService) quoteForContract(ctx context.Context, quoteId string, rateReq RateCalculationReq) (QuoteRes, ImplResponse, error) {
+	quote, err := s.repo.GetQuote(ctx, quoteId)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return QuoteRes{}, Response(http.StatusBadRequest, nil), fmt.Errorf("quote %s does not exist", quoteId)
+		}
+		return QuoteRes{}, Response(http.StatusInternalServerError, nil), err
+	}
+
+	if quote.ContractId != "" {
+		return QuoteRes{}, Response(http.StatusConflict, nil), fmt.Errorf("quote %s was already used for contract %s", quoteId, quote.ContractId)
+	}
+	validUntil, err := time.Parse(time.RFC3339, quote.ValidUntil)
+	if err != nil {
+		return QuoteRes{}, Response(http.StatusInternalServerError, nil), fmt.Errorf("quote %s has an invalid validUntil: %w", quoteId, err)
+	}
+	if s.now().After(validUntil) {
+		return QuoteRes{}, Response(http.StatusBadRequest, nil), fmt.Errorf("quote %s expired at %s", quoteId, quote.ValidUntil)
+	}
+	if fields := rateRequestDifferences(quote.Request, rateReq); len(fields) > 0 {
+		return QuoteRes{}, Response(http.StatusBadRequest, nil), fmt.Errorf("contract does not match quote %s in %s", quoteId, strings.Join(fields, ", "))
+	}
+
+	return quote, Response(http.StatusOK, nil), nil
+}
+
+// rateRequestDifferences returns the names of the fields that differ between two rate requests
+func rateRequestDifferences(a RateCalculationReq, b RateCalculationReq) []string {
+	fields := make([]string, 0)
+	if a.Coverage != b.Coverage {
+		fields = append(fields, "coverage")
+	}
+	if !strings.EqualFold(a.Breed, b.Breed) {
+		fields = append(fields, "breed")
+	}
+	if !strings.EqualFold(a.Color, b.Color) {
+		fields = append(fields, "color")
+	}
+	if a.BirthDate != b.BirthDate {
+		fields = append(fields, "birthDate")
+	}
+	if a.Neutered != b.Neutered {
+		fields = append(fields, "neutered")
+	}
+	if !strings.EqualFold(a.Personality, b.Personality) {
+		fields = append(fields, "personality")
+	}
+	if !strings.EqualFold(a.Environment, b.Environment) {
+		fields = append(fields, "environment")
+	}
+	if a.Weight != b.Weight {
+		fields = append(fields, "weight")
+	}
+	if int(a.ZipCode) != int(b.ZipCode) {
+		fields = append(fields, "zipCode")
+	}
+
+	return fields
+}
+
+// rateRequestFor describes the cat of a contract for the RateEngine. The region is taken from the customer's address.
+func rateRequestFor(contract ContractRes, customer CustomerRes) RateCalculationReq {
+	return RateCalculationReq{
+		Coverage:    contract.Coverage,
+		Breed:       contract.Breed,
+		Color:       contract.Color,
+		BirthDate:   contract.BirthDate,
+		Neutered:    contract.Neutered,
+		Personality: contract.Personality,
+		Environment: contract.Environment,
+		Weight:      contract.Weight,
+		ZipCode:     customer.Address.ZipCode,
+	}
+}
 
-	// TODO: Uncomment the next line to return response Response(200, []ContractRes{}) or use other options such as http.Ok ...
-	// return Response(200, []ContractRes{}), nil
+// contractLookupError maps a repository error for the given contract to a response
+func contractLookupError(contractId string, err error) (ImplResponse, error) {
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("contract %s not found", contractId)
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("GetCustomerContracts method not implemented")
+	return Response(http.StatusInternalServerError, nil), err
 }
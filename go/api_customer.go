@@ -11,6 +11,7 @@ package openapi
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -50,6 +51,11 @@ func NewCustomerAPIController(s CustomerAPIServicer, opts ...CustomerAPIOption)
 // Routes returns all the api routes for the CustomerAPIController
 func (c *CustomerAPIController) Routes() Routes {
 	return Routes{
+		"AssignCustomer": Route{
+			strings.ToUpper("Post"),
+			"/v1/customers/{customerId}/assign",
+			c.AssignCustomer,
+		},
 		"CreateCustomer": Route{
 			strings.ToUpper("Post"),
 			"/v1/customers",
@@ -75,6 +81,16 @@ func (c *CustomerAPIController) Routes() Routes {
 			"/v1/customers",
 			c.GetCustomers,
 		},
+		"GetEmployeeCustomers": Route{
+			strings.ToUpper("Get"),
+			"/v1/employees/{employeeId}/customers",
+			c.GetEmployeeCustomers,
+		},
+		"ReassignCustomers": Route{
+			strings.ToUpper("Post"),
+			"/v1/employees/{employeeId}/customers/reassign",
+			c.ReassignCustomers,
+		},
 		"SearchCustomers": Route{
 			strings.ToUpper("Get"),
 			"/v1/customers/search",
@@ -88,6 +104,39 @@ func (c *CustomerAPIController) Routes() Routes {
 	}
 }
 
+// AssignCustomer - Assign a customer to an advisor
+func (c *CustomerAPIController) AssignCustomer(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	customerIdParam := params["customerId"]
+	if customerIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"customerId"}, nil)
+		return
+	}
+	if err := validatePathUuid("customerId", customerIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	advisorAssignmentReqParam := AdvisorAssignmentReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&advisorAssignmentReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertAdvisorAssignmentReqRequired(advisorAssignmentReqParam), AssertAdvisorAssignmentReqConstraints(advisorAssignmentReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.AssignCustomer(r.Context(), customerIdParam, advisorAssignmentReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // CreateCustomer - Create a new customer
 func (c *CustomerAPIController) CreateCustomer(w http.ResponseWriter, r *http.Request) {
 	customerReqParam := CustomerReq{}
@@ -97,11 +146,7 @@ func (c *CustomerAPIController) CreateCustomer(w http.ResponseWriter, r *http.Re
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
-	if err := AssertCustomerReqRequired(customerReqParam); err != nil {
-		c.errorHandler(w, r, err, nil)
-		return
-	}
-	if err := AssertCustomerReqConstraints(customerReqParam); err != nil {
+	if err := validateAll(AssertCustomerReqRequired(customerReqParam), AssertCustomerReqConstraints(customerReqParam)); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
@@ -123,6 +168,10 @@ func (c *CustomerAPIController) DeleteCustomer(w http.ResponseWriter, r *http.Re
 		c.errorHandler(w, r, &RequiredError{"customerId"}, nil)
 		return
 	}
+	if err := validatePathUuid("customerId", customerIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
 	result, err := c.service.DeleteCustomer(r.Context(), customerIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
@@ -141,6 +190,10 @@ func (c *CustomerAPIController) GetCustomer(w http.ResponseWriter, r *http.Reque
 		c.errorHandler(w, r, &RequiredError{"customerId"}, nil)
 		return
 	}
+	if err := validatePathUuid("customerId", customerIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
 	result, err := c.service.GetCustomer(r.Context(), customerIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
@@ -164,6 +217,10 @@ func (c *CustomerAPIController) GetCustomerContracts(w http.ResponseWriter, r *h
 		c.errorHandler(w, r, &RequiredError{"customerId"}, nil)
 		return
 	}
+	if err := validatePathUuid("customerId", customerIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
 	var pageParam int32
 	if query.Has("page") {
 		param, err := parseNumericParameter[int32](
@@ -173,7 +230,7 @@ func (c *CustomerAPIController) GetCustomerContracts(w http.ResponseWriter, r *h
 			WithMaximum[int32](100),
 		)
 		if err != nil {
-			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
 			return
 		}
 
@@ -189,14 +246,25 @@ func (c *CustomerAPIController) GetCustomerContracts(w http.ResponseWriter, r *h
 			WithMaximum[int32](100),
 		)
 		if err != nil {
-			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
 			return
 		}
 
 		pageSizeParam = param
 	} else {
 	}
-	result, err := c.service.GetCustomerContracts(r.Context(), customerIdParam, pageParam, pageSizeParam)
+	var statusParam ContractStatus
+	if query.Has("status") {
+		param, err := NewContractStatusFromValue(query.Get("status"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "status", Err: err}, nil)
+			return
+		}
+
+		statusParam = param
+	} else {
+	}
+	result, err := c.service.GetCustomerContracts(r.Context(), customerIdParam, pageParam, pageSizeParam, statusParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -222,7 +290,7 @@ func (c *CustomerAPIController) GetCustomers(w http.ResponseWriter, r *http.Requ
 			WithMaximum[int32](100),
 		)
 		if err != nil {
-			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
 			return
 		}
 
@@ -238,7 +306,7 @@ func (c *CustomerAPIController) GetCustomers(w http.ResponseWriter, r *http.Requ
 			WithMaximum[int32](100),
 		)
 		if err != nil {
-			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
 			return
 		}
 
@@ -255,6 +323,103 @@ func (c *CustomerAPIController) GetCustomers(w http.ResponseWriter, r *http.Requ
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetEmployeeCustomers - Get the customers an employee advises
+func (c *CustomerAPIController) GetEmployeeCustomers(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	employeeIdParam := params["employeeId"]
+	if employeeIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"employeeId"}, nil)
+		return
+	}
+	employeeIdParam, ok := resolveEmployeeId(r.Context(), employeeIdParam)
+	if !ok {
+		c.errorHandler(w, r, fmt.Errorf("%s does not act as an employee", callerName(r.Context())), &ImplResponse{Code: http.StatusForbidden})
+		return
+	}
+	if err := validatePathUuid("employeeId", employeeIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetEmployeeCustomers(r.Context(), employeeIdParam, pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ReassignCustomers - Assign all customers of an advisor to another advisor
+func (c *CustomerAPIController) ReassignCustomers(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	employeeIdParam := params["employeeId"]
+	if employeeIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"employeeId"}, nil)
+		return
+	}
+	if err := validatePathUuid("employeeId", employeeIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	advisorAssignmentReqParam := AdvisorAssignmentReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&advisorAssignmentReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertAdvisorAssignmentReqRequired(advisorAssignmentReqParam), AssertAdvisorAssignmentReqConstraints(advisorAssignmentReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ReassignCustomers(r.Context(), employeeIdParam, advisorAssignmentReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // SearchCustomers - Search for customers
 func (c *CustomerAPIController) SearchCustomers(w http.ResponseWriter, r *http.Request) {
 	query, err := parseQuery(r.URL.RawQuery)
@@ -280,7 +445,7 @@ func (c *CustomerAPIController) SearchCustomers(w http.ResponseWriter, r *http.R
 			WithMaximum[int32](100),
 		)
 		if err != nil {
-			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
 			return
 		}
 
@@ -296,7 +461,7 @@ func (c *CustomerAPIController) SearchCustomers(w http.ResponseWriter, r *http.R
 			WithMaximum[int32](100),
 		)
 		if err != nil {
-			c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
 			return
 		}
 
@@ -321,6 +486,10 @@ func (c *CustomerAPIController) UpdateCustomer(w http.ResponseWriter, r *http.Re
 		c.errorHandler(w, r, &RequiredError{"customerId"}, nil)
 		return
 	}
+	if err := validatePathUuid("customerId", customerIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
 	customerReqParam := CustomerReq{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
@@ -328,11 +497,7 @@ func (c *CustomerAPIController) UpdateCustomer(w http.ResponseWriter, r *http.Re
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
-	if err := AssertCustomerReqRequired(customerReqParam); err != nil {
-		c.errorHandler(w, r, err, nil)
-		return
-	}
-	if err := AssertCustomerReqConstraints(customerReqParam); err != nil {
+	if err := validateAll(AssertCustomerReqRequired(customerReqParam), AssertCustomerReqConstraints(customerReqParam)); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
@@ -12,99 +12,357 @@ package openapi
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
 // CustomerAPIService is a service that implements the logic for the CustomerAPIServicer
 // This service should implement the business logic for every endpoint for the CustomerAPI API.
 // Include any external packages or services that will be required by this service.
 type CustomerAPIService struct {
+	repo  Repository
+	banks *BankDirectory
+	now   func() time.Time
+	// mu serializes creating and updating customers, so two customers cannot get the same tax id
+	mu sync.Mutex
 }
 
-// NewCustomerAPIService creates a default api service
-func NewCustomerAPIService() CustomerAPIServicer {
-	return &CustomerAPIService{}
+// NewCustomerAPIService creates a default api service looking up the banks of German IBANs in banks
+func NewCustomerAPIService(repo Repository, banks *BankDirectory) CustomerAPIServicer {
+	return &CustomerAPIService{repo: repo, banks: banks, now: time.Now}
+}
+
+// AssignCustomer - Assign a customer to an advisor
+func (s *CustomerAPIService) AssignCustomer(ctx context.Context, customerId string, advisorAssignmentReq AdvisorAssignmentReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	customer, err := s.repo.GetCustomer(ctx, customerId)
+	if err != nil {
+		return customerLookupError(customerId, err)
+	}
+	if result, err := s.checkAdvisor(ctx, advisorAssignmentReq.EmployeeId); err != nil {
+		return result, err
+	}
+
+	customer.AdvisorId = advisorAssignmentReq.EmployeeId
+	customer.Modified = auditStamp(ctx, s.now())
+	if err := s.repo.UpdateCustomer(ctx, customer); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, customer), nil
 }
 
 // CreateCustomer - Create a new customer
 func (s *CustomerAPIService) CreateCustomer(ctx context.Context, customerReq CustomerReq) (ImplResponse, error) {
-	// TODO - update CreateCustomer with the required logic for this service method.
-	// Add api_customer_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	// TODO: Uncomment the next line to return response Response(201, CustomerRes{}) or use other options such as http.Ok ...
-	// return Response(201, CustomerRes{}), nil
+	if result, err := s.checkTaxId(ctx, customerReq.TaxId, ""); err != nil {
+		return result, err
+	}
 
-	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
-	// return Response(400, nil),nil
+	customer := customerFromReq(newId(), customerReq)
+	if err := s.completeBankDetails(&customer.BankDetails); err != nil {
+		return Response(http.StatusBadRequest, nil), err
+	}
+	customer.Address.Id = newId()
+	customer.BankDetails.Id = newId()
+	if principal, ok := PrincipalFrom(ctx); ok {
+		customer.AdvisorId = principal.EmployeeId
+	}
+	customer.Created = auditStamp(ctx, s.now())
+	customer.Modified = customer.Created
 
-	return Response(http.StatusNotImplemented, nil), errors.New("CreateCustomer method not implemented")
+	if err := s.repo.CreateCustomer(ctx, customer); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusCreated, customer), nil
 }
 
 // DeleteCustomer - Delete a customer
 func (s *CustomerAPIService) DeleteCustomer(ctx context.Context, customerId string) (ImplResponse, error) {
-	// TODO - update DeleteCustomer with the required logic for this service method.
-	// Add api_customer_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+	if _, err := s.repo.GetCustomer(ctx, customerId); err != nil {
+		return customerLookupError(customerId, err)
+	}
+
+	contracts, err := s.repo.ListCustomerContracts(ctx, customerId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if len(contracts) > 0 {
+		return Response(http.StatusConflict, nil), fmt.Errorf("customer %s still has %d contracts", customerId, len(contracts))
+	}
 
-	// TODO: Uncomment the next line to return response Response(200, {}) or use other options such as http.Ok ...
-	// return Response(200, nil),nil
+	// The account of the customer goes first, so that no account is left behind for a customer that no longer exists
+	account, err := findAccount(ctx, s.repo, func(account Account) bool {
+		return account.CustomerId == customerId
+	})
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if err == nil {
+		if err := s.repo.DeleteAccount(ctx, account.Id); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
+	if err := s.repo.DeleteCustomer(ctx, customerId); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("DeleteCustomer method not implemented")
+	return Response(http.StatusOK, nil), nil
 }
 
 // GetCustomer - Get customer details
 func (s *CustomerAPIService) GetCustomer(ctx context.Context, customerId string) (ImplResponse, error) {
-	// TODO - update GetCustomer with the required logic for this service method.
-	// Add api_customer_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
-
-	// TODO: Uncomment the next line to return response Response(200, CustomerRes{}) or use other options such as http.Ok ...
-	// return Response(200, CustomerRes{}), nil
+	customer, err := s.repo.GetCustomer(ctx, customerId)
+	if err != nil {
+		return customerLookupError(customerId, err)
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("GetCustomer method not implemented")
+	return Response(http.StatusOK, customer), nil
 }
 
 // GetCustomerContracts - Get customer contracts
-func (s *CustomerAPIService) GetCustomerContracts(ctx context.Context, customerId string, page int32, pageSize int32) (ImplResponse, error) {
-	// TODO - update GetCustomerContracts with the required logic for this service method.
-	// Add api_customer_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+func (s *CustomerAPIService) GetCustomerContracts(ctx context.Context, customerId string, page int32, pageSize int32, status ContractStatus) (ImplResponse, error) {
+	if _, err := s.repo.GetCustomer(ctx, customerId); err != nil {
+		return customerLookupError(customerId, err)
+	}
 
-	// TODO: Uncomment the next line to return response Response(200, []ContractRes{}) or use other options such as http.Ok ...
-	// return Response(200, []ContractRes{}), nil
+	contracts, err := s.repo.ListCustomerContracts(ctx, customerId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("GetCustomerContracts method not implemented")
+	return Response(http.StatusOK, paginate(filterContracts(contracts, status), page, pageSize)), nil
 }
 
 // GetCustomers - Get all customers
 func (s *CustomerAPIService) GetCustomers(ctx context.Context, page int32, pageSize int32) (ImplResponse, error) {
-	// TODO - update GetCustomers with the required logic for this service method.
-	// Add api_customer_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+	customers, err := s.repo.ListCustomers(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	visible := make([]CustomerRes, 0, len(customers))
+	for _, customer := range customers {
+		if visibleCustomer(ctx, customer) {
+			visible = append(visible, customer)
+		}
+	}
+
+	return Response(http.StatusOK, paginate(visible, page, pageSize)), nil
+}
+
+// GetEmployeeCustomers - Get the customers an employee advises
+func (s *CustomerAPIService) GetEmployeeCustomers(ctx context.Context, employeeId string, page int32, pageSize int32) (ImplResponse, error) {
+	if _, err := s.repo.GetEmployee(ctx, employeeId); err != nil {
+		return employeeLookupError(employeeId, err)
+	}
+
+	customers, err := s.repo.ListCustomers(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	advised := make([]CustomerRes, 0)
+	for _, customer := range customers {
+		if customer.AdvisorId == employeeId {
+			advised = append(advised, customer)
+		}
+	}
+
+	return Response(http.StatusOK, paginate(advised, page, pageSize)), nil
+}
+
+// ReassignCustomers - Assign all customers of an advisor to another advisor
+// This hands over the customers of an employee who leaves, before or after the employee is deactivated.
+func (s *CustomerAPIService) ReassignCustomers(ctx context.Context, employeeId string, advisorAssignmentReq AdvisorAssignmentReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.repo.GetEmployee(ctx, employeeId); err != nil {
+		return employeeLookupError(employeeId, err)
+	}
+	if advisorAssignmentReq.EmployeeId == employeeId {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("the customers of employee %s cannot be reassigned to the same employee", employeeId)
+	}
+	if result, err := s.checkAdvisor(ctx, advisorAssignmentReq.EmployeeId); err != nil {
+		return result, err
+	}
+
+	customers, err := s.repo.ListCustomers(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
 
-	// TODO: Uncomment the next line to return response Response(200, []CustomerRes{}) or use other options such as http.Ok ...
-	// return Response(200, []CustomerRes{}), nil
+	stamp := auditStamp(ctx, s.now())
+	reassigned := make([]CustomerRes, 0)
+	for _, customer := range customers {
+		if customer.AdvisorId != employeeId {
+			continue
+		}
+		customer.AdvisorId = advisorAssignmentReq.EmployeeId
+		customer.Modified = stamp
+		if err := s.repo.UpdateCustomer(ctx, customer); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+		reassigned = append(reassigned, customer)
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("GetCustomers method not implemented")
+	return Response(http.StatusOK, reassigned), nil
 }
 
 // SearchCustomers - Search for customers
 func (s *CustomerAPIService) SearchCustomers(ctx context.Context, text string, page int32, pageSize int32) (ImplResponse, error) {
-	// TODO - update SearchCustomers with the required logic for this service method.
-	// Add api_customer_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+	customers, err := s.repo.ListCustomers(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
 
-	// TODO: Uncomment the next line to return response Response(200, []CustomerRes{}) or use other options such as http.Ok ...
-	// return Response(200, []CustomerRes{}), nil
+	text = strings.ToLower(strings.TrimSpace(text))
+	matches := make([]CustomerRes, 0)
+	for _, customer := range customers {
+		if visibleCustomer(ctx, customer) && customerMatches(customer, text) {
+			matches = append(matches, customer)
+		}
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("SearchCustomers method not implemented")
+	return Response(http.StatusOK, paginate(matches, page, pageSize)), nil
 }
 
 // UpdateCustomer - Update a customer
 func (s *CustomerAPIService) UpdateCustomer(ctx context.Context, customerId string, customerReq CustomerReq) (ImplResponse, error) {
-	// TODO - update UpdateCustomer with the required logic for this service method.
-	// Add api_customer_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	// TODO: Uncomment the next line to return response Response(200, {}) or use other options such as http.Ok ...
-	// return Response(200, nil),nil
+	existing, err := s.repo.GetCustomer(ctx, customerId)
+	if err != nil {
+		return customerLookupError(customerId, err)
+	}
+	if result, err := s.checkTaxId(ctx, customerReq.TaxId, customerId); err != nil {
+		return result, err
+	}
+
+	customer := customerFromReq(customerId, customerReq)
+	if err := s.completeBankDetails(&customer.BankDetails); err != nil {
+		return Response(http.StatusBadRequest, nil), err
+	}
+	customer.Address.Id = existing.Address.Id
+	customer.BankDetails.Id = existing.BankDetails.Id
+	customer.AdvisorId = existing.AdvisorId
+	customer.Created = existing.Created
+	customer.Modified = auditStamp(ctx, s.now())
+
+	if err := s.repo.UpdateCustomer(ctx, customer); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, customer), nil
+}
+
+// checkAdvisor returns an error if the employee a customer is assigned to does not exist or is deactivated
+func (s *CustomerAPIService) checkAdvisor(ctx context.Context, employeeId string) (ImplResponse, error) {
+	employee, err := s.repo.GetEmployee(ctx, employeeId)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return Response(http.StatusBadRequest, nil), fmt.Errorf("employee %s does not exist", employeeId)
+		}
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if employee.Status == EmployeeStatusInactive {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("employee %s is deactivated", employeeId)
+	}
+
+	return ImplResponse{}, nil
+}
+
+// checkTaxId returns a conflict if a customer other than customerId already has the tax id
+func (s *CustomerAPIService) checkTaxId(ctx context.Context, taxId string, customerId string) (ImplResponse, error) {
+	existing, err := s.repo.FindCustomerByTaxId(ctx, taxId)
+	if errors.Is(err, ErrNotFound) {
+		return ImplResponse{}, nil
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if existing.Id != customerId {
+		return Response(http.StatusConflict, nil), fmt.Errorf("tax id %s already belongs to customer %s", taxId, existing.Id)
+	}
+
+	return ImplResponse{}, nil
+}
+
+// completeBankDetails sets the name of the bank of a German IBAN and its BIC if the client omitted it.
+// The BIC can only be omitted if the bank is known.
+func (s *CustomerAPIService) completeBankDetails(bankDetails *BankDetails) error {
+	bankDetails.BankName = ""
+	bank, ok := s.banks.LookupIban(bankDetails.Iban)
+	if ok {
+		bankDetails.BankName = bank.Name
+		if bankDetails.Bic == "" {
+			bankDetails.Bic = bank.Bic
+		}
+	}
+	if bankDetails.Bic != "" {
+		return nil
+	}
+
+	reason := "is required, it can only be derived from German IBANs of known banks"
+	if ok {
+		reason = fmt.Sprintf("is required, %s has no BIC", bank.Name)
+	}
+
+	return &ValidationError{Errors: []ProblemError{{Field: "$.bankDetails.bic", Code: codeRequired, Message: reason}}}
+}
+
+// customerFromReq builds the customer resource with the given id from a request body
+func customerFromReq(id string, customerReq CustomerReq) CustomerRes {
+	return CustomerRes{
+		Id:                   id,
+		Email:                customerReq.Email,
+		FirstName:            customerReq.FirstName,
+		LastName:             customerReq.LastName,
+		Title:                customerReq.Title,
+		FamilyStatus:         customerReq.FamilyStatus,
+		BirthDate:            customerReq.BirthDate,
+		SocialSecurityNumber: customerReq.SocialSecurityNumber,
+		TaxId:                customerReq.TaxId,
+		JobStatus:            customerReq.JobStatus,
+		Address:              customerReq.Address,
+		BankDetails:          customerReq.BankDetails,
+	}
+}
+
+// customerMatches reports whether the lower-cased search text is contained in any of the customer's searchable fields
+func customerMatches(customer CustomerRes, text string) bool {
+	fields := []string{
+		customer.FirstName,
+		customer.LastName,
+		customer.FirstName + " " + customer.LastName,
+		customer.Email,
+		customer.Address.City,
+		customer.Address.Street,
+	}
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), text) {
+			return true
+		}
+	}
+
+	return false
+}
 
-	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
-	// return Response(400, nil),nil
+// customerLookupError maps a repository error for the given customer to a response
+func customerLookupError(customerId string, err error) (ImplResponse, error) {
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("customer %s not found", customerId)
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("UpdateCustomer method not implemented")
+	return Response(http.StatusInternalServerError, nil), err
 }
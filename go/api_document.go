@@ -0,0 +1,307 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// DocumentAPIController binds http requests to an api service and writes the service results to the http response
+type DocumentAPIController struct {
+	service DocumentAPIServicer
+	errorHandler ErrorHandler
+	maxUploadSize int64
+}
+
+// maxUploadFiles is the number of files a single upload may contain
+const maxUploadFiles = 10
+
+// uploadFormOverhead is the number of bytes an upload may have in addition to its files, for its other fields
+// and the headers of its parts
+const uploadFormOverhead = 1 << 20
+
+// DocumentAPIOption for how the controller is set up.
+type DocumentAPIOption func(*DocumentAPIController)
+
+// WithDocumentAPIErrorHandler inject ErrorHandler into controller
+func WithDocumentAPIErrorHandler(h ErrorHandler) DocumentAPIOption {
+	return func(c *DocumentAPIController) {
+		c.errorHandler = h
+	}
+}
+
+// WithDocumentAPIMaxUploadSize limits the size of each uploaded file, so that larger uploads are refused
+// before they are read. The servicer checks the size of the stored documents on its own.
+func WithDocumentAPIMaxUploadSize(maxSize int64) DocumentAPIOption {
+	return func(c *DocumentAPIController) {
+		c.maxUploadSize = maxSize
+	}
+}
+
+// NewDocumentAPIController creates a default api controller
+func NewDocumentAPIController(s DocumentAPIServicer, opts ...DocumentAPIOption) Router {
+	controller := &DocumentAPIController{
+		service:       s,
+		errorHandler:  DefaultErrorHandler,
+		maxUploadSize: DefaultMaxUploadSize,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the DocumentAPIController
+func (c *DocumentAPIController) Routes() Routes {
+	return Routes{
+		"DownloadDocument": Route{
+			strings.ToUpper("Get"),
+			"/v1/documents/{documentId}/content",
+			c.DownloadDocument,
+		},
+		"GetClaimDocuments": Route{
+			strings.ToUpper("Get"),
+			"/v1/claims/{claimId}/documents",
+			c.GetClaimDocuments,
+		},
+		"GetContractDocuments": Route{
+			strings.ToUpper("Get"),
+			"/v1/contracts/{contractId}/documents",
+			c.GetContractDocuments,
+		},
+		"GetDocument": Route{
+			strings.ToUpper("Get"),
+			"/v1/documents/{documentId}",
+			c.GetDocument,
+		},
+		"UploadClaimDocuments": Route{
+			strings.ToUpper("Post"),
+			"/v1/claims/{claimId}/documents",
+			c.UploadClaimDocuments,
+		},
+		"UploadContractDocuments": Route{
+			strings.ToUpper("Post"),
+			"/v1/contracts/{contractId}/documents",
+			c.UploadContractDocuments,
+		},
+	}
+}
+
+// DownloadDocument - Download the content of a document
+func (c *DocumentAPIController) DownloadDocument(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	documentIdParam := params["documentId"]
+	if documentIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"documentId"}, nil)
+		return
+	}
+	if err := validatePathUuid("documentId", documentIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.DownloadDocument(r.Context(), documentIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	if file, ok := result.Body.(*os.File); ok {
+		defer file.Close()
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetClaimDocuments - Get the documents of a claim
+func (c *DocumentAPIController) GetClaimDocuments(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	claimIdParam := params["claimId"]
+	if claimIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"claimId"}, nil)
+		return
+	}
+	if err := validatePathUuid("claimId", claimIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetClaimDocuments(r.Context(), claimIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetContractDocuments - Get the documents of a contract
+func (c *DocumentAPIController) GetContractDocuments(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetContractDocuments(r.Context(), contractIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetDocument - Get document details
+func (c *DocumentAPIController) GetDocument(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	documentIdParam := params["documentId"]
+	if documentIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"documentId"}, nil)
+		return
+	}
+	if err := validatePathUuid("documentId", documentIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetDocument(r.Context(), documentIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UploadClaimDocuments - Upload invoices or vet reports to a claim
+func (c *DocumentAPIController) UploadClaimDocuments(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	claimIdParam := params["claimId"]
+	if claimIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"claimId"}, nil)
+		return
+	}
+	if err := validatePathUuid("claimId", claimIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if !c.parseUploadForm(w, r) {
+		return
+	}
+	kindParam, err := NewDocumentKindFromValue(r.FormValue("kind"))
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "kind", Err: err}, nil)
+		return
+	}
+	filesParam, err := ReadFormFilesToTempFiles(r, "files")
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "files", Err: err}, nil)
+		return
+	}
+	if len(filesParam) == 0 {
+		c.errorHandler(w, r, &RequiredError{"files"}, nil)
+		return
+	}
+	result, err := c.service.UploadClaimDocuments(r.Context(), claimIdParam, kindParam, filesParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UploadContractDocuments - Upload documents to a contract
+func (c *DocumentAPIController) UploadContractDocuments(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if !c.parseUploadForm(w, r) {
+		return
+	}
+	kindParam, err := NewDocumentKindFromValue(r.FormValue("kind"))
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "kind", Err: err}, nil)
+		return
+	}
+	filesParam, err := ReadFormFilesToTempFiles(r, "files")
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "files", Err: err}, nil)
+		return
+	}
+	if len(filesParam) == 0 {
+		c.errorHandler(w, r, &RequiredError{"files"}, nil)
+		return
+	}
+	result, err := c.service.UploadContractDocuments(r.Context(), contractIdParam, kindParam, filesParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// parseUploadForm parses the multipart form of an upload, reading no more from the body than maxUploadFiles
+// files of maxUploadSize bytes take. It reports false after answering uploads that are too large or malformed.
+func (c *DocumentAPIController) parseUploadForm(w http.ResponseWriter, r *http.Request) bool {
+	limit := maxUploadFiles*c.maxUploadSize + uploadFormOverhead
+	body := &countingReadCloser{ReadCloser: r.Body}
+	r.Body = http.MaxBytesReader(w, body, limit)
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		// http.MaxBytesReader reads one byte beyond the limit to tell that the body exceeds it
+		if body.n > limit {
+			c.errorHandler(w, r, fmt.Errorf("uploads may have at most %d bytes", limit), &ImplResponse{Code: http.StatusRequestEntityTooLarge})
+			return false
+		}
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return false
+	}
+	if n := len(r.MultipartForm.File["files"]); n > maxUploadFiles {
+		c.errorHandler(w, r, fmt.Errorf("the upload has %d files, at most %d are allowed", n, maxUploadFiles), &ImplResponse{Code: http.StatusRequestEntityTooLarge})
+		return false
+	}
+
+	return true
+}
+
+// countingReadCloser counts the bytes read from a request body
+type countingReadCloser struct {
+	io.ReadCloser
+	n int64
+}
+
+func (b *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,278 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultMaxUploadSize is the size in bytes documents may have unless configured otherwise
+const DefaultMaxUploadSize = 10 << 20
+
+// documentContentTypes lists the media types accepted for uploaded documents
+var documentContentTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+
+// DocumentAPIService is a service that implements the logic for the DocumentAPIServicer
+// This service should implement the business logic for every endpoint for the DocumentAPI API.
+// Include any external packages or services that will be required by this service.
+type DocumentAPIService struct {
+	repo    Repository
+	blobs   BlobStore
+	maxSize int64
+	now     func() time.Time
+}
+
+// NewDocumentAPIService creates a default api service accepting documents of up to maxSize bytes
+func NewDocumentAPIService(repo Repository, blobs BlobStore, maxSize int64) DocumentAPIServicer {
+	return &DocumentAPIService{
+		repo:    repo,
+		blobs:   blobs,
+		maxSize: maxSize,
+		now:     time.Now,
+	}
+}
+
+// DownloadDocument - Download the content of a document
+func (s *DocumentAPIService) DownloadDocument(ctx context.Context, documentId string) (ImplResponse, error) {
+	document, err := s.repo.GetDocument(ctx, documentId)
+	if err != nil {
+		return documentLookupError(documentId, err)
+	}
+
+	file, err := s.blobs.Open(documentBlobKey(document))
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), fmt.Errorf("content of document %s: %w", documentId, err)
+	}
+	checksum := sha256.New()
+	if _, err := io.Copy(checksum, file); err != nil {
+		file.Close()
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if hex.EncodeToString(checksum.Sum(nil)) != document.Checksum {
+		file.Close()
+		return Response(http.StatusInternalServerError, nil), fmt.Errorf("content of document %s does not match its checksum", documentId)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		file.Close()
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, file), nil
+}
+
+// GetClaimDocuments - Get the documents of a claim
+func (s *DocumentAPIService) GetClaimDocuments(ctx context.Context, claimId string) (ImplResponse, error) {
+	if _, err := s.repo.GetClaim(ctx, claimId); err != nil {
+		return claimLookupError(claimId, err)
+	}
+
+	documents, err := s.repo.ListClaimDocuments(ctx, claimId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, documents), nil
+}
+
+// GetContractDocuments - Get the documents of a contract
+func (s *DocumentAPIService) GetContractDocuments(ctx context.Context, contractId string) (ImplResponse, error) {
+	if _, err := s.repo.GetContract(ctx, contractId); err != nil {
+		return contractLookupError(contractId, err)
+	}
+
+	documents, err := s.repo.ListContractDocuments(ctx, contractId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, documents), nil
+}
+
+// GetDocument - Get document details
+func (s *DocumentAPIService) GetDocument(ctx context.Context, documentId string) (ImplResponse, error) {
+	document, err := s.repo.GetDocument(ctx, documentId)
+	if err != nil {
+		return documentLookupError(documentId, err)
+	}
+
+	return Response(http.StatusOK, document), nil
+}
+
+// UploadClaimDocuments - Upload invoices or vet reports to a claim
+func (s *DocumentAPIService) UploadClaimDocuments(ctx context.Context, claimId string, kind DocumentKind, files []*os.File) (ImplResponse, error) {
+	defer removeFiles(files)
+
+	if _, err := s.repo.GetClaim(ctx, claimId); err != nil {
+		return claimLookupError(claimId, err)
+	}
+
+	return s.storeDocuments(ctx, kind, files, func(document *DocumentRes) {
+		document.ClaimId = claimId
+	})
+}
+
+// UploadContractDocuments - Upload documents to a contract
+func (s *DocumentAPIService) UploadContractDocuments(ctx context.Context, contractId string, kind DocumentKind, files []*os.File) (ImplResponse, error) {
+	defer removeFiles(files)
+
+	if _, err := s.repo.GetContract(ctx, contractId); err != nil {
+		return contractLookupError(contractId, err)
+	}
+
+	return s.storeDocuments(ctx, kind, files, func(document *DocumentRes) {
+		document.ContractId = contractId
+	})
+}
+
+// storeDocuments stores the uploaded files as documents attached by attach. All files are
+// inspected before the first one is stored, so an upload is accepted or rejected as a whole.
+func (s *DocumentAPIService) storeDocuments(ctx context.Context, kind DocumentKind, files []*os.File, attach func(*DocumentRes)) (ImplResponse, error) {
+	documents := make([]DocumentRes, 0, len(files))
+	for _, file := range files {
+		document, result, err := s.inspect(file)
+		if err != nil {
+			return result, err
+		}
+		document.Id = newId()
+		document.Kind = kind
+		document.UploadedAt = s.now().UTC().Format(time.RFC3339)
+		attach(&document)
+		documents = append(documents, document)
+	}
+
+	for i, document := range documents {
+		if err := s.storeDocument(ctx, document, files[i]); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
+
+	return Response(http.StatusCreated, documents), nil
+}
+
+// inspect checks the size and content type of an uploaded file and computes its checksum
+func (s *DocumentAPIService) inspect(upload *os.File) (DocumentRes, ImplResponse, error) {
+	fileName := uploadFileName(upload)
+	file, err := os.Open(upload.Name())
+	if err != nil {
+		return DocumentRes{}, Response(http.StatusInternalServerError, nil), err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return DocumentRes{}, Response(http.StatusInternalServerError, nil), err
+	}
+	if info.Size() == 0 {
+		return DocumentRes{}, Response(http.StatusBadRequest, nil), fmt.Errorf("%s is empty", fileName)
+	}
+	if info.Size() > s.maxSize {
+		return DocumentRes{}, Response(http.StatusRequestEntityTooLarge, nil),
+			fmt.Errorf("%s has %d bytes, documents may have at most %d", fileName, info.Size(), s.maxSize)
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return DocumentRes{}, Response(http.StatusInternalServerError, nil), err
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil || !isDocumentContentType(contentType) {
+		return DocumentRes{}, Response(http.StatusUnsupportedMediaType, nil),
+			fmt.Errorf("%s is not a document of type %s", fileName, strings.Join(documentContentTypes, ", "))
+	}
+
+	checksum := sha256.New()
+	checksum.Write(head[:n])
+	if _, err := io.Copy(checksum, file); err != nil {
+		return DocumentRes{}, Response(http.StatusInternalServerError, nil), err
+	}
+
+	return DocumentRes{
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        info.Size(),
+		Checksum:    hex.EncodeToString(checksum.Sum(nil)),
+	}, ImplResponse{}, nil
+}
+
+// storeDocument copies the content of the upload to the blob store and saves the document's metadata
+func (s *DocumentAPIService) storeDocument(ctx context.Context, document DocumentRes, upload *os.File) error {
+	file, err := os.Open(upload.Name())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	key := documentBlobKey(document)
+	if _, err := s.blobs.Put(key, file); err != nil {
+		return err
+	}
+	if err := s.repo.CreateDocument(ctx, document); err != nil {
+		s.blobs.Delete(key)
+		return err
+	}
+
+	return nil
+}
+
+// documentBlobKey returns the key the content of the document is stored under in the blob store
+func documentBlobKey(document DocumentRes) string {
+	return path.Join(document.Id, document.FileName)
+}
+
+// uploadFileName recovers the client's file name from a temporary file created by readFileHeaderToTempFile
+func uploadFileName(upload *os.File) string {
+	name := filepath.Base(upload.Name())
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	if name == "" || name == "." || name == ".." {
+		return "document"
+	}
+
+	return name
+}
+
+// isDocumentContentType reports whether documents of the media type are accepted
+func isDocumentContentType(contentType string) bool {
+	for _, allowed := range documentContentTypes {
+		if allowed == contentType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeFiles deletes the temporary files of an upload
+func removeFiles(files []*os.File) {
+	for _, file := range files {
+		os.Remove(file.Name())
+	}
+}
+
+// documentLookupError maps a repository error for the given document to a response
+func documentLookupError(documentId string, err error) (ImplResponse, error) {
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("document %s not found", documentId)
+	}
+
+	return Response(http.StatusInternalServerError, nil), err
+}
@@ -0,0 +1,145 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// DunningAPIController binds http requests to an api service and writes the service results to the http response
+type DunningAPIController struct {
+	service DunningAPIServicer
+	errorHandler ErrorHandler
+}
+
+// DunningAPIOption for how the controller is set up.
+type DunningAPIOption func(*DunningAPIController)
+
+// WithDunningAPIErrorHandler inject ErrorHandler into controller
+func WithDunningAPIErrorHandler(h ErrorHandler) DunningAPIOption {
+	return func(c *DunningAPIController) {
+		c.errorHandler = h
+	}
+}
+
+// NewDunningAPIController creates a default api controller
+func NewDunningAPIController(s DunningAPIServicer, opts ...DunningAPIOption) Router {
+	controller := &DunningAPIController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the DunningAPIController
+func (c *DunningAPIController) Routes() Routes {
+	return Routes{
+		"GetDunningCase": Route{
+			strings.ToUpper("Get"),
+			"/v1/dunning-cases/{dunningCaseId}",
+			c.GetDunningCase,
+		},
+		"GetDunningCases": Route{
+			strings.ToUpper("Get"),
+			"/v1/dunning-cases",
+			c.GetDunningCases,
+		},
+	}
+}
+
+// GetDunningCase - Get a dunning case with its notices
+func (c *DunningAPIController) GetDunningCase(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	dunningCaseIdParam := params["dunningCaseId"]
+	if dunningCaseIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"dunningCaseId"}, nil)
+		return
+	}
+	if err := validatePathUuid("dunningCaseId", dunningCaseIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetDunningCase(r.Context(), dunningCaseIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetDunningCases - Get the dunning cases of overdue premiums, latest first
+func (c *DunningAPIController) GetDunningCases(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	var statusParam DunningCaseStatus
+	if query.Has("status") {
+		param, err := NewDunningCaseStatusFromValue(query.Get("status"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "status", Err: err}, nil)
+			return
+		}
+
+		statusParam = param
+	} else {
+	}
+	result, err := c.service.GetDunningCases(r.Context(), pageParam, pageSizeParam, statusParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
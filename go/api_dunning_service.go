@@ -0,0 +1,65 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// DunningAPIService is a service that implements the logic for the DunningAPIServicer
+// This service should implement the business logic for every endpoint for the DunningAPI API.
+// Include any external packages or services that will be required by this service.
+type DunningAPIService struct {
+	repo Repository
+}
+
+// NewDunningAPIService creates a default api service for the cases opened by the DunningScheduler
+func NewDunningAPIService(repo Repository) DunningAPIServicer {
+	return &DunningAPIService{
+		repo: repo,
+	}
+}
+
+// GetDunningCase - Get a dunning case with its notices
+func (s *DunningAPIService) GetDunningCase(ctx context.Context, dunningCaseId string) (ImplResponse, error) {
+	dunningCase, err := s.repo.GetDunningCase(ctx, dunningCaseId)
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("dunning case %s not found", dunningCaseId)
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, dunningCase), nil
+}
+
+// GetDunningCases - Get the dunning cases of overdue premiums, latest first
+func (s *DunningAPIService) GetDunningCases(ctx context.Context, page int32, pageSize int32, status DunningCaseStatus) (ImplResponse, error) {
+	cases, err := s.repo.ListDunningCases(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	filtered := make([]DunningCaseRes, 0)
+	for _, dunningCase := range cases {
+		if status == "" || dunningCase.Status == status {
+			filtered = append(filtered, dunningCase)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].OpenedOn > filtered[j].OpenedOn
+	})
+
+	return Response(http.StatusOK, paginate(filtered, page, pageSize)), nil
+}
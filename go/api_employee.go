@@ -55,14 +55,29 @@ func (c *EmployeeAPIController) Routes() Routes {
 			"/v1/employees",
 			c.CreateEmployee,
 		},
+		"DeleteEmployee": Route{
+			strings.ToUpper("Delete"),
+			"/v1/employees/{employeeId}",
+			c.DeleteEmployee,
+		},
 		"GetEmployee": Route{
 			strings.ToUpper("Get"),
 			"/v1/employees/{employeeId}",
 			c.GetEmployee,
 		},
+		"GetEmployees": Route{
+			strings.ToUpper("Get"),
+			"/v1/employees",
+			c.GetEmployees,
+		},
+		"ReactivateEmployee": Route{
+			strings.ToUpper("Post"),
+			"/v1/employees/{employeeId}/reactivate",
+			c.ReactivateEmployee,
+		},
 		"UpdateEmployee": Route{
 			strings.ToUpper("Patch"),
-			"/v1/employees",
+			"/v1/employees/{employeeId}",
 			c.UpdateEmployee,
 		},
 	}
@@ -77,15 +92,33 @@ func (c *EmployeeAPIController) CreateEmployee(w http.ResponseWriter, r *http.Re
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
-	if err := AssertEmployeeReqRequired(employeeReqParam); err != nil {
+	if err := validateAll(AssertEmployeeReqRequired(employeeReqParam), AssertEmployeeReqConstraints(employeeReqParam)); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	if err := AssertEmployeeReqConstraints(employeeReqParam); err != nil {
+	result, err := c.service.CreateEmployee(r.Context(), employeeReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteEmployee - Deactivate an employee
+func (c *EmployeeAPIController) DeleteEmployee(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	employeeIdParam := params["employeeId"]
+	if employeeIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"employeeId"}, nil)
+		return
+	}
+	if err := validatePathUuid("employeeId", employeeIdParam); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.CreateEmployee(r.Context(), employeeReqParam)
+	result, err := c.service.DeleteEmployee(r.Context(), employeeIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -103,6 +136,10 @@ func (c *EmployeeAPIController) GetEmployee(w http.ResponseWriter, r *http.Reque
 		c.errorHandler(w, r, &RequiredError{"employeeId"}, nil)
 		return
 	}
+	if err := validatePathUuid("employeeId", employeeIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
 	result, err := c.service.GetEmployee(r.Context(), employeeIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
@@ -113,24 +150,130 @@ func (c *EmployeeAPIController) GetEmployee(w http.ResponseWriter, r *http.Reque
 	EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetEmployees - Get the employees
+func (c *EmployeeAPIController) GetEmployees(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	var statusParam EmployeeStatus
+	if query.Has("status") {
+		param, err := NewEmployeeStatusFromValue(query.Get("status"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "status", Err: err}, nil)
+			return
+		}
+
+		statusParam = param
+	} else {
+	}
+	var roleParam EmployeeRole
+	if query.Has("role") {
+		param, err := NewEmployeeRoleFromValue(query.Get("role"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "role", Err: err}, nil)
+			return
+		}
+
+		roleParam = param
+	} else {
+	}
+	var textParam string
+	if query.Has("text") {
+		param := query.Get("text")
+
+		textParam = param
+	} else {
+	}
+	result, err := c.service.GetEmployees(r.Context(), pageParam, pageSizeParam, statusParam, roleParam, textParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ReactivateEmployee - Reactivate a deactivated employee
+func (c *EmployeeAPIController) ReactivateEmployee(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	employeeIdParam := params["employeeId"]
+	if employeeIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"employeeId"}, nil)
+		return
+	}
+	if err := validatePathUuid("employeeId", employeeIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ReactivateEmployee(r.Context(), employeeIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // UpdateEmployee - Update an employee
 func (c *EmployeeAPIController) UpdateEmployee(w http.ResponseWriter, r *http.Request) {
-	employeeReqParam := EmployeeReq{}
-	d := json.NewDecoder(r.Body)
-	d.DisallowUnknownFields()
-	if err := d.Decode(&employeeReqParam); err != nil {
-		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+	params := mux.Vars(r)
+	employeeIdParam := params["employeeId"]
+	if employeeIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"employeeId"}, nil)
 		return
 	}
-	if err := AssertEmployeeReqRequired(employeeReqParam); err != nil {
+	if err := validatePathUuid("employeeId", employeeIdParam); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	if err := AssertEmployeeReqConstraints(employeeReqParam); err != nil {
+	employeeUpdateReqParam := EmployeeUpdateReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&employeeUpdateReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertEmployeeUpdateReqRequired(employeeUpdateReqParam), AssertEmployeeUpdateReqConstraints(employeeUpdateReqParam)); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.UpdateEmployee(r.Context(), employeeReqParam)
+	result, err := c.service.UpdateEmployee(r.Context(), employeeIdParam, employeeUpdateReqParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -11,56 +11,328 @@ package openapi
 
 import (
 	"context"
-	"net/http"
 	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 )
 
 // EmployeeAPIService is a service that implements the logic for the EmployeeAPIServicer
 // This service should implement the business logic for every endpoint for the EmployeeAPI API.
 // Include any external packages or services that will be required by this service.
 type EmployeeAPIService struct {
+	repo      Repository
+	mailer    Mailer
+	portalURL string
+	now       func() time.Time
+	// mu serializes changes of employees and their accounts, so that no email address is used twice
+	mu sync.Mutex
 }
 
-// NewEmployeeAPIService creates a default api service
-func NewEmployeeAPIService() EmployeeAPIServicer {
-	return &EmployeeAPIService{}
+// NewEmployeeAPIService creates a default api service. New employees are invited by email to set the password
+// of their account on the portal at portalURL.
+func NewEmployeeAPIService(repo Repository, mailer Mailer, portalURL string) EmployeeAPIServicer {
+	return &EmployeeAPIService{
+		repo:      repo,
+		mailer:    mailer,
+		portalURL: portalURL,
+		now:       time.Now,
+	}
 }
 
 // CreateEmployee - Create a new employee
 func (s *EmployeeAPIService) CreateEmployee(ctx context.Context, employeeReq EmployeeReq) (ImplResponse, error) {
-	// TODO - update CreateEmployee with the required logic for this service method.
-	// Add api_employee_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	// TODO: Uncomment the next line to return response Response(201, EmployeeRes{}) or use other options such as http.Ok ...
-	// return Response(201, EmployeeRes{}), nil
+	employee := EmployeeRes{
+		Id:        newId(),
+		FirstName: employeeReq.FirstName,
+		LastName:  employeeReq.LastName,
+		Address:   employeeReq.Address,
+		Email:     employeeReq.Email,
+		Roles:     employeeReq.Roles,
+		Status:    EmployeeStatusActive,
+	}
+	employee.Address.Id = newId()
+	if len(employee.Roles) == 0 {
+		employee.Roles = []EmployeeRole{EmployeeRoleEmployee}
+	}
+	if result, err := s.checkEmail(ctx, employee.Email, employee.Id); err != nil {
+		return result, err
+	}
 
-	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
-	// return Response(400, nil),nil
+	if err := s.repo.CreateEmployee(ctx, employee); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if err := s.syncAccount(ctx, employee); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("CreateEmployee method not implemented")
+	return Response(http.StatusCreated, employee), nil
+}
+
+// DeleteEmployee - Deactivate an employee
+// Employees are never deleted, since claims and customers refer to them. A deactivated employee cannot log in,
+// and the sessions issued before are refused.
+func (s *EmployeeAPIService) DeleteEmployee(ctx context.Context, employeeId string) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	employee, err := s.repo.GetEmployee(ctx, employeeId)
+	if err != nil {
+		return employeeLookupError(employeeId, err)
+	}
+	if employee.Status != EmployeeStatusActive {
+		return Response(http.StatusConflict, nil), fmt.Errorf("%w: employee %s is already %s", ErrInvalidTransition, employee.Id, employee.Status)
+	}
+
+	employee.Status = EmployeeStatusInactive
+	employee.DeactivatedAt = s.now().UTC().Format(time.RFC3339)
+	if err := s.repo.UpdateEmployee(ctx, employee); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if err := s.syncAccount(ctx, employee); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, employee), nil
 }
 
 // GetEmployee - Get employee details
 func (s *EmployeeAPIService) GetEmployee(ctx context.Context, employeeId string) (ImplResponse, error) {
-	// TODO - update GetEmployee with the required logic for this service method.
-	// Add api_employee_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+	employee, err := s.repo.GetEmployee(ctx, employeeId)
+	if err != nil {
+		return employeeLookupError(employeeId, err)
+	}
+
+	return Response(http.StatusOK, employee), nil
+}
 
-	// TODO: Uncomment the next line to return response Response(200, EmployeeRes{}) or use other options such as http.Ok ...
-	// return Response(200, EmployeeRes{}), nil
+// GetEmployees - Get the employees
+func (s *EmployeeAPIService) GetEmployees(ctx context.Context, page int32, pageSize int32, status EmployeeStatus, role EmployeeRole, text string) (ImplResponse, error) {
+	employees, err := s.repo.ListEmployees(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("GetEmployee method not implemented")
+	text = strings.ToLower(strings.TrimSpace(text))
+	matches := make([]EmployeeRes, 0)
+	for _, employee := range employees {
+		if status != "" && employee.Status != status {
+			continue
+		}
+		if role != "" && !hasEmployeeRole(employee, role) {
+			continue
+		}
+		if text != "" && !strings.Contains(strings.ToLower(employee.FirstName+" "+employee.LastName+" "+employee.Email), text) {
+			continue
+		}
+		matches = append(matches, employee)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].LastName != matches[j].LastName {
+			return matches[i].LastName < matches[j].LastName
+		}
+		return matches[i].FirstName < matches[j].FirstName
+	})
+
+	return Response(http.StatusOK, paginate(matches, page, pageSize)), nil
+}
+
+// ReactivateEmployee - Reactivate a deactivated employee
+func (s *EmployeeAPIService) ReactivateEmployee(ctx context.Context, employeeId string) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	employee, err := s.repo.GetEmployee(ctx, employeeId)
+	if err != nil {
+		return employeeLookupError(employeeId, err)
+	}
+	if employee.Status != EmployeeStatusInactive {
+		return Response(http.StatusConflict, nil), fmt.Errorf("%w: employee %s is already %s", ErrInvalidTransition, employee.Id, employee.Status)
+	}
+
+	employee.Status = EmployeeStatusActive
+	employee.DeactivatedAt = ""
+	if err := s.repo.UpdateEmployee(ctx, employee); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if err := s.syncAccount(ctx, employee); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, employee), nil
 }
 
 // UpdateEmployee - Update an employee
-func (s *EmployeeAPIService) UpdateEmployee(ctx context.Context, employeeReq EmployeeReq) (ImplResponse, error) {
-	// TODO - update UpdateEmployee with the required logic for this service method.
-	// Add api_employee_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+func (s *EmployeeAPIService) UpdateEmployee(ctx context.Context, employeeId string, employeeUpdateReq EmployeeUpdateReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	employee, err := s.repo.GetEmployee(ctx, employeeId)
+	if err != nil {
+		return employeeLookupError(employeeId, err)
+	}
+
+	if employeeUpdateReq.FirstName != nil {
+		employee.FirstName = *employeeUpdateReq.FirstName
+	}
+	if employeeUpdateReq.LastName != nil {
+		employee.LastName = *employeeUpdateReq.LastName
+	}
+	if employeeUpdateReq.Address != nil {
+		address := *employeeUpdateReq.Address
+		address.Id = employee.Address.Id
+		employee.Address = address
+	}
+	if employeeUpdateReq.Email != nil {
+		if result, err := s.checkEmail(ctx, *employeeUpdateReq.Email, employee.Id); err != nil {
+			return result, err
+		}
+		employee.Email = *employeeUpdateReq.Email
+	}
+	if employeeUpdateReq.Roles != nil {
+		employee.Roles = employeeUpdateReq.Roles
+	}
+
+	if err := s.repo.UpdateEmployee(ctx, employee); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if err := s.syncAccount(ctx, employee); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
 
-	// TODO: Uncomment the next line to return response Response(200, {}) or use other options such as http.Ok ...
-	// return Response(200, nil),nil
+	return Response(http.StatusOK, employee), nil
+}
+
+// checkEmail returns an error if the email address is used by an account of anyone but the employee
+func (s *EmployeeAPIService) checkEmail(ctx context.Context, email string, employeeId string) (ImplResponse, error) {
+	account, err := s.repo.FindAccountByEmail(ctx, email)
+	if errors.Is(err, ErrNotFound) {
+		return ImplResponse{}, nil
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if account.EmployeeId != employeeId {
+		return Response(http.StatusConflict, nil), fmt.Errorf("the email address %s is already used by another account", email)
+	}
+
+	return ImplResponse{}, nil
+}
+
+// syncAccount brings the account of an employee in line with the employee: its email address, its roles and
+// whether it is disabled. An employee without account gets one and is invited to set its password, as is an
+// employee whose account was never used and whose email address changed.
+func (s *EmployeeAPIService) syncAccount(ctx context.Context, employee EmployeeRes) error {
+	if employee.Email == "" {
+		return nil
+	}
+
+	account, err := findAccount(ctx, s.repo, func(account Account) bool {
+		return account.EmployeeId == employee.Id
+	})
+	created := errors.Is(err, ErrNotFound)
+	if created {
+		account = Account{
+			Id:         newId(),
+			Status:     AccountStatusPending,
+			EmployeeId: employee.Id,
+			CreatedAt:  s.now().UTC().Format(time.RFC3339),
+		}
+	} else if err != nil {
+		return err
+	}
+
+	invite := created || (account.Status == AccountStatusPending && normalizeEmail(account.Email) != normalizeEmail(employee.Email))
+	account.Email = employee.Email
+	roles := make([]string, 0, len(employee.Roles))
+	for _, role := range employee.Roles {
+		roles = append(roles, string(role))
+	}
+	if !created && !sameRoles(account.Roles, roles) {
+		account.RolesChangedAt = s.now().UTC().Format(time.RFC3339Nano)
+	}
+	account.Roles = roles
+
+	switch {
+	case employee.Status == EmployeeStatusInactive:
+		account.Status = AccountStatusDisabled
+		account.ResetTokenHash = ""
+		account.ResetTokenExpiry = ""
+		invite = false
+	case account.Status == AccountStatusDisabled && account.VerifiedAt != "":
+		account.Status = AccountStatusActive
+	case account.Status == AccountStatusDisabled:
+		account.Status = AccountStatusPending
+		invite = true
+	}
+
+	var token string
+	if invite {
+		var tokenHash string
+		if token, tokenHash, err = newSecretToken(); err != nil {
+			return err
+		}
+		account.ResetTokenHash = tokenHash
+		account.ResetTokenExpiry = s.now().Add(invitationTokenValidity).UTC().Format(time.RFC3339)
+	}
+
+	if created {
+		err = s.repo.CreateAccount(ctx, account)
+	} else {
+		err = s.repo.UpdateAccount(ctx, account)
+	}
+	if err != nil || !invite {
+		return err
+	}
+
+	return s.mailer.Send(ctx, Mail{
+		To:      account.Email,
+		Subject: "Your account at Cat Insurance",
+		Body: fmt.Sprintf("Dear %s %s,\n\nan account was created for you. Set its password to log in:\n%s\n\nThe link is valid until %s.\n",
+			employee.FirstName, employee.LastName, portalLink(s.portalURL, "/reset-password", token), account.ResetTokenExpiry),
+	})
+}
+
+// sameRoles reports whether both lists hold the same roles, regardless of their order
+func sameRoles(a []string, b []string) bool {
+	count := make(map[string]int, len(a))
+	for _, role := range a {
+		count[role]++
+	}
+	for _, role := range b {
+		count[role]--
+	}
+	for _, n := range count {
+		if n != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasEmployeeRole reports whether the employee has the role
+func hasEmployeeRole(employee EmployeeRes, role EmployeeRole) bool {
+	for _, r := range employee.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
 
-	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
-	// return Response(400, nil),nil
+// employeeLookupError maps a repository error for the given employee to a response
+func employeeLookupError(employeeId string, err error) (ImplResponse, error) {
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("employee %s not found", employeeId)
+	}
 
-	return Response(http.StatusNotImplemented, nil), errors.New("UpdateEmployee method not implemented")
+	return Response(http.StatusInternalServerError, nil), err
 }
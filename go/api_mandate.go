@@ -0,0 +1,285 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// MandateAPIController binds http requests to an api service and writes the service results to the http response
+type MandateAPIController struct {
+	service MandateAPIServicer
+	errorHandler ErrorHandler
+}
+
+// MandateAPIOption for how the controller is set up.
+type MandateAPIOption func(*MandateAPIController)
+
+// WithMandateAPIErrorHandler inject ErrorHandler into controller
+func WithMandateAPIErrorHandler(h ErrorHandler) MandateAPIOption {
+	return func(c *MandateAPIController) {
+		c.errorHandler = h
+	}
+}
+
+// NewMandateAPIController creates a default api controller
+func NewMandateAPIController(s MandateAPIServicer, opts ...MandateAPIOption) Router {
+	controller := &MandateAPIController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the MandateAPIController
+func (c *MandateAPIController) Routes() Routes {
+	return Routes{
+		"CreateMandate": Route{
+			strings.ToUpper("Post"),
+			"/v1/customers/{customerId}/mandates",
+			c.CreateMandate,
+		},
+		"GetContractMandates": Route{
+			strings.ToUpper("Get"),
+			"/v1/contracts/{contractId}/mandates",
+			c.GetContractMandates,
+		},
+		"GetCustomerMandates": Route{
+			strings.ToUpper("Get"),
+			"/v1/customers/{customerId}/mandates",
+			c.GetCustomerMandates,
+		},
+		"GetMandate": Route{
+			strings.ToUpper("Get"),
+			"/v1/mandates/{mandateId}",
+			c.GetMandate,
+		},
+		"RevokeMandate": Route{
+			strings.ToUpper("Post"),
+			"/v1/mandates/{mandateId}/revoke",
+			c.RevokeMandate,
+		},
+	}
+}
+
+// CreateMandate - Record a SEPA direct debit mandate signed by a customer
+func (c *MandateAPIController) CreateMandate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	customerIdParam := params["customerId"]
+	if customerIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"customerId"}, nil)
+		return
+	}
+	if err := validatePathUuid("customerId", customerIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	mandateReqParam := MandateReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&mandateReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertMandateReqRequired(mandateReqParam), AssertMandateReqConstraints(mandateReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateMandate(r.Context(), customerIdParam, mandateReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetContractMandates - Get the mandates of a contract
+func (c *MandateAPIController) GetContractMandates(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	contractIdParam := params["contractId"]
+	if contractIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"contractId"}, nil)
+		return
+	}
+	if err := validatePathUuid("contractId", contractIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetContractMandates(r.Context(), contractIdParam, pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetCustomerMandates - Get the mandates of a customer
+func (c *MandateAPIController) GetCustomerMandates(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	customerIdParam := params["customerId"]
+	if customerIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"customerId"}, nil)
+		return
+	}
+	if err := validatePathUuid("customerId", customerIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	result, err := c.service.GetCustomerMandates(r.Context(), customerIdParam, pageParam, pageSizeParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetMandate - Get mandate details
+func (c *MandateAPIController) GetMandate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mandateIdParam := params["mandateId"]
+	if mandateIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"mandateId"}, nil)
+		return
+	}
+	if err := validatePathUuid("mandateId", mandateIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetMandate(r.Context(), mandateIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// RevokeMandate - Revoke a mandate
+func (c *MandateAPIController) RevokeMandate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mandateIdParam := params["mandateId"]
+	if mandateIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"mandateId"}, nil)
+		return
+	}
+	if err := validatePathUuid("mandateId", mandateIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	mandateRevocationReqParam := MandateRevocationReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&mandateRevocationReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertMandateRevocationReqRequired(mandateRevocationReqParam), AssertMandateRevocationReqConstraints(mandateRevocationReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.RevokeMandate(r.Context(), mandateIdParam, mandateRevocationReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
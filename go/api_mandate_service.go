@@ -0,0 +1,191 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// MandateAPIService is a service that implements the logic for the MandateAPIServicer
+// This service should implement the business logic for every endpoint for the MandateAPI API.
+// Include any external packages or services that will be required by this service.
+type MandateAPIService struct {
+	repo       Repository
+	creditorId string
+	now        func() time.Time
+	// mu serializes changes to mandates, so a contract cannot get two active mandates. It is shared with the
+	// BillingAPIService, so that a billing run does not write back a mandate revoked meanwhile as active.
+	mu *sync.Mutex
+}
+
+// NewMandateAPIService creates a default api service issuing mandates for the SEPA creditor identifier creditorId.
+// collections is the lock the BillingAPIService collects premiums with the mandates under.
+func NewMandateAPIService(repo Repository, creditorId string, collections *sync.Mutex) MandateAPIServicer {
+	return &MandateAPIService{
+		repo:       repo,
+		creditorId: creditorId,
+		now:        time.Now,
+		mu:         collections,
+	}
+}
+
+// CreateMandate - Record a SEPA direct debit mandate signed by a customer
+func (s *MandateAPIService) CreateMandate(ctx context.Context, customerId string, mandateReq MandateReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	customer, err := s.repo.GetCustomer(ctx, customerId)
+	if err != nil {
+		return customerLookupError(customerId, err)
+	}
+
+	contract, err := s.repo.GetContract(ctx, mandateReq.ContractId)
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("contract %s does not exist", mandateReq.ContractId)
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if contract.CustomerId != customerId {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("contract %s belongs to another customer", contract.Id)
+	}
+	switch contract.Status {
+	case ContractStatusCancelled, ContractStatusExpired:
+		return Response(http.StatusConflict, nil), fmt.Errorf("contract %s is %s, its premiums are no longer collected", contract.Id, contract.Status)
+	}
+
+	signed, err := time.Parse(dateLayout, mandateReq.SignatureDate)
+	if err != nil {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("signatureDate %q is not a valid date", mandateReq.SignatureDate)
+	}
+	if signed.After(s.now()) {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("signatureDate %s lies in the future", mandateReq.SignatureDate)
+	}
+
+	mandates, err := s.repo.ListContractMandates(ctx, contract.Id)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	for _, mandate := range mandates {
+		if mandate = withMandateExpiry(mandate, s.now()); mandate.Status == MandateStatusActive {
+			return Response(http.StatusConflict, nil), fmt.Errorf("contract %s already has the active mandate %s, revoke it first", contract.Id, mandate.Reference)
+		}
+	}
+
+	mandate := MandateRes{
+		Id:            newId(),
+		Reference:     newMandateReference(signed),
+		CustomerId:    customerId,
+		ContractId:    contract.Id,
+		CreditorId:    s.creditorId,
+		Iban:          customer.BankDetails.Iban,
+		Bic:           customer.BankDetails.Bic,
+		AccountHolder: customer.BankDetails.Name,
+		SignatureDate: mandateReq.SignatureDate,
+		Status:        MandateStatusActive,
+		SequenceType:  MandateSequenceTypeFrst,
+		CreatedAt:     s.now().UTC().Format(time.RFC3339),
+	}
+	if mandate = withMandateExpiry(mandate, s.now()); mandate.Status != MandateStatusActive {
+		return Response(http.StatusBadRequest, nil), fmt.Errorf("a mandate signed on %s has expired", mandateReq.SignatureDate)
+	}
+
+	if err := s.repo.CreateMandate(ctx, mandate); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusCreated, mandate), nil
+}
+
+// GetContractMandates - Get the mandates of a contract
+func (s *MandateAPIService) GetContractMandates(ctx context.Context, contractId string, page int32, pageSize int32) (ImplResponse, error) {
+	if _, err := s.repo.GetContract(ctx, contractId); err != nil {
+		return contractLookupError(contractId, err)
+	}
+
+	mandates, err := s.repo.ListContractMandates(ctx, contractId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, paginate(s.withExpiry(mandates), page, pageSize)), nil
+}
+
+// GetCustomerMandates - Get the mandates of a customer
+func (s *MandateAPIService) GetCustomerMandates(ctx context.Context, customerId string, page int32, pageSize int32) (ImplResponse, error) {
+	if _, err := s.repo.GetCustomer(ctx, customerId); err != nil {
+		return customerLookupError(customerId, err)
+	}
+
+	mandates, err := s.repo.ListCustomerMandates(ctx, customerId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, paginate(s.withExpiry(mandates), page, pageSize)), nil
+}
+
+// GetMandate - Get mandate details
+func (s *MandateAPIService) GetMandate(ctx context.Context, mandateId string) (ImplResponse, error) {
+	mandate, err := s.repo.GetMandate(ctx, mandateId)
+	if err != nil {
+		return mandateLookupError(mandateId, err)
+	}
+
+	return Response(http.StatusOK, withMandateExpiry(mandate, s.now())), nil
+}
+
+// RevokeMandate - Revoke a mandate
+func (s *MandateAPIService) RevokeMandate(ctx context.Context, mandateId string, mandateRevocationReq MandateRevocationReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mandate, err := s.repo.GetMandate(ctx, mandateId)
+	if err != nil {
+		return mandateLookupError(mandateId, err)
+	}
+
+	mandate = withMandateExpiry(mandate, s.now())
+	if err := revokeMandate(&mandate, mandateRevocationReq.Reason, s.now()); err != nil {
+		if errors.Is(err, ErrInvalidTransition) {
+			return Response(http.StatusConflict, nil), err
+		}
+		return Response(http.StatusBadRequest, nil), err
+	}
+
+	if err := s.repo.UpdateMandate(ctx, mandate); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, mandate), nil
+}
+
+// withExpiry marks the mandates that expired as of today
+func (s *MandateAPIService) withExpiry(mandates []MandateRes) []MandateRes {
+	for i := range mandates {
+		mandates[i] = withMandateExpiry(mandates[i], s.now())
+	}
+
+	return mandates
+}
+
+// mandateLookupError maps a repository error for the given mandate to a response
+func mandateLookupError(mandateId string, err error) (ImplResponse, error) {
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("mandate %s not found", mandateId)
+	}
+
+	return Response(http.StatusInternalServerError, nil), err
+}
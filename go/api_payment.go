@@ -0,0 +1,215 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// PaymentAPIController binds http requests to an api service and writes the service results to the http response
+type PaymentAPIController struct {
+	service PaymentAPIServicer
+	errorHandler ErrorHandler
+}
+
+// PaymentAPIOption for how the controller is set up.
+type PaymentAPIOption func(*PaymentAPIController)
+
+// WithPaymentAPIErrorHandler inject ErrorHandler into controller
+func WithPaymentAPIErrorHandler(h ErrorHandler) PaymentAPIOption {
+	return func(c *PaymentAPIController) {
+		c.errorHandler = h
+	}
+}
+
+// NewPaymentAPIController creates a default api controller
+func NewPaymentAPIController(s PaymentAPIServicer, opts ...PaymentAPIOption) Router {
+	controller := &PaymentAPIController{
+		service:      s,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(controller)
+	}
+
+	return controller
+}
+
+// Routes returns all the api routes for the PaymentAPIController
+func (c *PaymentAPIController) Routes() Routes {
+	return Routes{
+		"GetBankStatement": Route{
+			strings.ToUpper("Get"),
+			"/v1/bank-statements/{bankStatementId}",
+			c.GetBankStatement,
+		},
+		"GetStatementEntries": Route{
+			strings.ToUpper("Get"),
+			"/v1/statement-entries",
+			c.GetStatementEntries,
+		},
+		"ImportBankStatement": Route{
+			strings.ToUpper("Post"),
+			"/v1/bank-statements",
+			c.ImportBankStatement,
+		},
+		"ResolveStatementEntry": Route{
+			strings.ToUpper("Post"),
+			"/v1/statement-entries/{statementEntryId}/resolve",
+			c.ResolveStatementEntry,
+		},
+	}
+}
+
+// GetBankStatement - Get an imported bank statement with its entries
+func (c *PaymentAPIController) GetBankStatement(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	bankStatementIdParam := params["bankStatementId"]
+	if bankStatementIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"bankStatementId"}, nil)
+		return
+	}
+	if err := validatePathUuid("bankStatementId", bankStatementIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.GetBankStatement(r.Context(), bankStatementIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetStatementEntries - Get the entries of the imported bank statements
+func (c *PaymentAPIController) GetStatementEntries(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var pageParam int32
+	if query.Has("page") {
+		param, err := parseNumericParameter[int32](
+			query.Get("page"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "page", Err: err}, nil)
+			return
+		}
+
+		pageParam = param
+	} else {
+	}
+	var pageSizeParam int32
+	if query.Has("pageSize") {
+		param, err := parseNumericParameter[int32](
+			query.Get("pageSize"),
+			WithParse[int32](parseInt32),
+			WithMinimum[int32](1),
+			WithMaximum[int32](100),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "pageSize", Err: err}, nil)
+			return
+		}
+
+		pageSizeParam = param
+	} else {
+	}
+	var statusParam StatementEntryStatus
+	if query.Has("status") {
+		param, err := NewStatementEntryStatusFromValue(query.Get("status"))
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "status", Err: err}, nil)
+			return
+		}
+
+		statusParam = param
+	} else {
+	}
+	result, err := c.service.GetStatementEntries(r.Context(), pageParam, pageSizeParam, statusParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ImportBankStatement - Import a camt.053 bank statement and reconcile its entries
+func (c *PaymentAPIController) ImportBankStatement(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	fileParam, err := ReadFormFileToTempFile(r, "file")
+	if errors.Is(err, http.ErrMissingFile) {
+		c.errorHandler(w, r, &RequiredError{"file"}, nil)
+		return
+	}
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Param: "file", Err: err}, nil)
+		return
+	}
+	result, err := c.service.ImportBankStatement(r.Context(), fileParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// ResolveStatementEntry - Resolve an unmatched entry of a bank statement
+func (c *PaymentAPIController) ResolveStatementEntry(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	statementEntryIdParam := params["statementEntryId"]
+	if statementEntryIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"statementEntryId"}, nil)
+		return
+	}
+	if err := validatePathUuid("statementEntryId", statementEntryIdParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	statementEntryResolutionReqParam := StatementEntryResolutionReq{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&statementEntryResolutionReqParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := validateAll(AssertStatementEntryResolutionReqRequired(statementEntryResolutionReqParam), AssertStatementEntryResolutionReqConstraints(statementEntryResolutionReqParam)); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.ResolveStatementEntry(r.Context(), statementEntryIdParam, statementEntryResolutionReqParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+}
@@ -0,0 +1,240 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// PaymentAPIService is a service that implements the logic for the PaymentAPIServicer
+// This service should implement the business logic for every endpoint for the PaymentAPI API.
+// Include any external packages or services that will be required by this service.
+type PaymentAPIService struct {
+	repo     Repository
+	creditor Creditor
+	now      func() time.Time
+	// mu serializes imports and resolutions, so no payment is applied twice. It is shared with the
+	// BillingAPIService, so that imports and billing runs do not overwrite each other's changes to installments.
+	mu *sync.Mutex
+}
+
+// NewPaymentAPIService creates a default api service reconciling the statements of the creditor's account.
+// collections is the lock the BillingAPIService changes installments under.
+func NewPaymentAPIService(repo Repository, creditor Creditor, collections *sync.Mutex) PaymentAPIServicer {
+	return &PaymentAPIService{
+		repo:     repo,
+		creditor: creditor,
+		now:      time.Now,
+		mu:       collections,
+	}
+}
+
+// GetBankStatement - Get an imported bank statement with its entries
+func (s *PaymentAPIService) GetBankStatement(ctx context.Context, bankStatementId string) (ImplResponse, error) {
+	statement, err := s.repo.GetBankStatement(ctx, bankStatementId)
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("bank statement %s not found", bankStatementId)
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	entries, err := s.repo.ListBankStatementEntries(ctx, bankStatementId)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].BookingDate < entries[j].BookingDate
+	})
+
+	return Response(http.StatusOK, withEntries(statement, entries)), nil
+}
+
+// GetStatementEntries - Get the entries of the imported bank statements
+func (s *PaymentAPIService) GetStatementEntries(ctx context.Context, page int32, pageSize int32, status StatementEntryStatus) (ImplResponse, error) {
+	entries, err := s.repo.ListStatementEntries(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	filtered := make([]StatementEntryRes, 0)
+	for _, entry := range entries {
+		if status == "" || entry.Status == status {
+			filtered = append(filtered, entry)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].BookingDate < filtered[j].BookingDate
+	})
+
+	return Response(http.StatusOK, paginate(filtered, page, pageSize)), nil
+}
+
+// ImportBankStatement - Import a camt.053 bank statement and reconcile its entries
+func (s *PaymentAPIService) ImportBankStatement(ctx context.Context, file *os.File) (ImplResponse, error) {
+	defer os.Remove(file.Name())
+
+	upload, err := os.Open(file.Name())
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	defer upload.Close()
+
+	parsed, err := parseCamt053(upload)
+	if err != nil {
+		return Response(http.StatusBadRequest, nil), err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	imported, err := s.repo.ListBankStatements(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	for _, statement := range parsed {
+		if statement.iban != s.creditor.Iban {
+			return Response(http.StatusBadRequest, nil), fmt.Errorf("statement %s is for the account %s, premiums are collected to %s", statement.id, statement.iban, s.creditor.Iban)
+		}
+		for _, previous := range imported {
+			if previous.StatementId == statement.id && previous.Iban == statement.iban {
+				return Response(http.StatusConflict, nil), fmt.Errorf("statement %s was already imported as %s", statement.id, previous.Id)
+			}
+		}
+	}
+
+	installments, err := s.repo.ListInstallments(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	runs, err := s.repo.ListBillingRuns(ctx)
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	reconciler := newReconciler(installments, runs)
+
+	statements := make([]BankStatementRes, 0, len(parsed))
+	for _, parsedStatement := range parsed {
+		statement := BankStatementRes{
+			Id:          newId(),
+			StatementId: parsedStatement.id,
+			Iban:        parsedStatement.iban,
+			CreatedAt:   parsedStatement.createdAt,
+			ImportedAt:  s.now().UTC().Format(time.RFC3339),
+		}
+		entries := make([]StatementEntryRes, 0, len(parsedStatement.entries))
+		for _, entry := range parsedStatement.entries {
+			entry.Id = newId()
+			entry.BankStatementId = statement.Id
+			entry.Status = StatementEntryStatusMatched
+			entry.InstallmentIds, entry.UnmatchedReason = reconciler.match(entry)
+			if entry.InstallmentIds == nil {
+				entry.Status = StatementEntryStatusUnmatched
+			}
+			entries = append(entries, entry)
+		}
+		statements = append(statements, withEntries(statement, entries))
+	}
+
+	for _, installment := range installments {
+		if !reconciler.changed[installment.Id] {
+			continue
+		}
+		if err := s.repo.UpdateInstallment(ctx, installment); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+	}
+	for _, statement := range statements {
+		if err := s.repo.CreateBankStatement(ctx, statement); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+		for _, entry := range statement.Entries {
+			if err := s.repo.CreateStatementEntry(ctx, entry); err != nil {
+				return Response(http.StatusInternalServerError, nil), err
+			}
+		}
+	}
+
+	return Response(http.StatusCreated, statements), nil
+}
+
+// ResolveStatementEntry - Resolve an unmatched entry of a bank statement
+func (s *PaymentAPIService) ResolveStatementEntry(ctx context.Context, statementEntryId string, statementEntryResolutionReq StatementEntryResolutionReq) (ImplResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.repo.GetStatementEntry(ctx, statementEntryId)
+	if errors.Is(err, ErrNotFound) {
+		return Response(http.StatusNotFound, nil), fmt.Errorf("statement entry %s not found", statementEntryId)
+	}
+	if err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+	if entry.Status != StatementEntryStatusUnmatched {
+		return Response(http.StatusConflict, nil), fmt.Errorf("%w: statement entry %s is %s and needs no resolution", ErrInvalidTransition, entry.Id, entry.Status)
+	}
+
+	entry.Status = StatementEntryStatusDismissed
+	if installmentId := statementEntryResolutionReq.InstallmentId; installmentId != "" {
+		installment, err := s.repo.GetInstallment(ctx, installmentId)
+		if errors.Is(err, ErrNotFound) {
+			return Response(http.StatusBadRequest, nil), fmt.Errorf("installment %s does not exist", installmentId)
+		}
+		if err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+
+		if entry.CreditDebit == CreditDebitIndicatorCrdt {
+			err = payInstallment(&installment, entry.BookingDate)
+		} else {
+			err = returnInstallment(&installment, entry.BookingDate, entry.ReturnReason)
+		}
+		if err != nil {
+			return Response(http.StatusConflict, nil), err
+		}
+		if err := s.repo.UpdateInstallment(ctx, installment); err != nil {
+			return Response(http.StatusInternalServerError, nil), err
+		}
+		entry.Status = StatementEntryStatusResolved
+		entry.InstallmentIds = []string{installment.Id}
+	}
+	entry.ResolutionNote = statementEntryResolutionReq.Note
+	entry.ResolvedAt = s.now().UTC().Format(time.RFC3339)
+
+	if err := s.repo.UpdateStatementEntry(ctx, entry); err != nil {
+		return Response(http.StatusInternalServerError, nil), err
+	}
+
+	return Response(http.StatusOK, entry), nil
+}
+
+// withEntries adds the entries to a bank statement and counts the matched and unmatched ones
+func withEntries(statement BankStatementRes, entries []StatementEntryRes) BankStatementRes {
+	statement.Entries = entries
+	statement.NumberOfEntries = int32(len(entries))
+	statement.Matched, statement.Unmatched = 0, 0
+	for _, entry := range entries {
+		switch entry.Status {
+		case StatementEntryStatusMatched, StatementEntryStatusResolved:
+			statement.Matched++
+		case StatementEntryStatusUnmatched:
+			statement.Unmatched++
+		}
+	}
+
+	return statement
+}
@@ -0,0 +1,277 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// APIKeyHeader is the request header service-to-service calls send their API key in
+const APIKeyHeader = "X-API-Key"
+
+// AuthMethod tells how a principal was authenticated
+type AuthMethod string
+
+// List of AuthMethod
+const (
+	AuthMethodBearer AuthMethod = "bearer"
+	AuthMethodAPIKey AuthMethod = "apiKey"
+)
+
+// Principal is the caller of a request as authenticated by the Authenticator. Servicers find it in the
+// context of the request with PrincipalFrom.
+type Principal struct {
+	// Subject identifies the caller, the sub claim of a token or the name of an API key
+	Subject string
+	Method  AuthMethod
+	Roles   []string
+	// CustomerId and EmployeeId link the caller to the customer or employee it acts as, if any
+	CustomerId string
+	EmployeeId string
+	// IssuedAt is when the bearer token was issued, if it tells
+	IssuedAt time.Time
+}
+
+// HasRole reports whether the principal was granted the role
+func (p Principal) HasRole(role string) bool {
+	return contains(p.Roles, role)
+}
+
+type principalKey struct{}
+
+// PrincipalFrom returns the principal authenticated for the request of the context, or false for
+// routes that allow anonymous access
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// WithPrincipal returns a copy of ctx carrying the principal
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// auditStamp records the principal of ctx as changing a resource at now. Without a principal, e.g. in the
+// schedulers, the change is attributed to the server itself.
+func auditStamp(ctx context.Context, now time.Time) *AuditStamp {
+	stamp := &AuditStamp{At: now.UTC().Format(time.RFC3339)}
+	if principal, ok := PrincipalFrom(ctx); ok {
+		stamp.Subject = principal.Subject
+		stamp.EmployeeId = principal.EmployeeId
+		stamp.CustomerId = principal.CustomerId
+	}
+
+	return stamp
+}
+
+// APIKey is a static key a service authenticates with. Only the SHA-256 hash of the key is configured,
+// so the configuration does not disclose the keys.
+type APIKey struct {
+	// Name is the subject of the principal authenticated with the key
+	Name string `json:"name"`
+	// Hash is the hex encoded SHA-256 hash of the key
+	Hash  string   `json:"hash"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ParseAPIKeys decodes the list of API keys in its JSON representation
+func ParseAPIKeys(data []byte) ([]APIKey, error) {
+	keys := make([]APIKey, 0)
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&keys); err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool, len(keys))
+	for i, key := range keys {
+		if key.Name == "" || names[key.Name] {
+			return nil, fmt.Errorf("API key %d needs a unique name", i)
+		}
+		names[key.Name] = true
+		if hash, err := hex.DecodeString(key.Hash); err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("API key %s needs the hex encoded SHA-256 hash of the key", key.Name)
+		}
+	}
+
+	return keys, nil
+}
+
+// LoadAPIKeys reads the list of API keys from a JSON file
+func LoadAPIKeys(path string) ([]APIKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := ParseAPIKeys(data)
+	if err != nil {
+		return nil, fmt.Errorf("API keys %s: %w", path, err)
+	}
+
+	return keys, nil
+}
+
+// Authenticator authenticates the requests of every route registered by NewRouter, either with a JWT
+// bearer token or with an API key. Requests to routes not allowing anonymous access are rejected with
+// 401 Unauthorized unless they are authenticated.
+type Authenticator struct {
+	keys      []JSONWebKey
+	issuer    string
+	audience  string
+	apiKeys   map[string]APIKey
+	anonymous map[string]bool
+	now       func() time.Time
+
+	errorHandler ErrorHandler
+}
+
+// AuthenticatorOption for how the authenticator is set up.
+type AuthenticatorOption func(*Authenticator)
+
+// WithTokenKeys adds the keys bearer tokens are verified with
+func WithTokenKeys(keys ...JSONWebKey) AuthenticatorOption {
+	return func(a *Authenticator) {
+		a.keys = append(a.keys, keys...)
+	}
+}
+
+// WithTokenIssuer requires bearer tokens to be issued by issuer
+func WithTokenIssuer(issuer string) AuthenticatorOption {
+	return func(a *Authenticator) {
+		a.issuer = issuer
+	}
+}
+
+// WithTokenAudience requires bearer tokens to be meant for audience
+func WithTokenAudience(audience string) AuthenticatorOption {
+	return func(a *Authenticator) {
+		a.audience = audience
+	}
+}
+
+// WithAPIKeys adds the API keys services authenticate with
+func WithAPIKeys(keys ...APIKey) AuthenticatorOption {
+	return func(a *Authenticator) {
+		for _, key := range keys {
+			a.apiKeys[strings.ToLower(key.Hash)] = key
+		}
+	}
+}
+
+// WithAnonymousRoutes allows anonymous access to the routes with the given names, e.g. CalculateRate.
+// Credentials sent to these routes are still verified.
+func WithAnonymousRoutes(names ...string) AuthenticatorOption {
+	return func(a *Authenticator) {
+		for _, name := range names {
+			a.anonymous[name] = true
+		}
+	}
+}
+
+// WithAuthenticatorClock injects the clock the expiry of tokens is checked against
+func WithAuthenticatorClock(now func() time.Time) AuthenticatorOption {
+	return func(a *Authenticator) {
+		a.now = now
+	}
+}
+
+// WithAuthenticatorErrorHandler inject ErrorHandler into authenticator
+func WithAuthenticatorErrorHandler(h ErrorHandler) AuthenticatorOption {
+	return func(a *Authenticator) {
+		a.errorHandler = h
+	}
+}
+
+// NewAuthenticator creates an authenticator accepting the configured token keys and API keys
+func NewAuthenticator(opts ...AuthenticatorOption) *Authenticator {
+	authenticator := &Authenticator{
+		apiKeys:      make(map[string]APIKey),
+		anonymous:    make(map[string]bool),
+		now:          time.Now,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(authenticator)
+	}
+
+	return authenticator
+}
+
+// Authenticate wraps the handler of the named route, passing the authenticated principal in the
+// context of the request
+func (a *Authenticator) Authenticate(inner http.Handler, name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := a.authenticate(r)
+		if errors.Is(err, errNoCredentials) && a.anonymous[name] {
+			inner.ServeHTTP(w, r)
+			return
+		}
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="cat-insurance"`)
+			a.errorHandler(w, r, err, &ImplResponse{Code: http.StatusUnauthorized})
+			return
+		}
+
+		inner.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+	})
+}
+
+// errNoCredentials is returned for requests without a bearer token or API key
+var errNoCredentials = errors.New("the request has no bearer token or API key")
+
+// authenticate verifies the bearer token or the API key of a request
+func (a *Authenticator) authenticate(r *http.Request) (Principal, error) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		hash := sha256.Sum256([]byte(key))
+		apiKey, ok := a.apiKeys[hex.EncodeToString(hash[:])]
+		if !ok {
+			return Principal{}, errors.New("the API key is invalid")
+		}
+		return Principal{Subject: apiKey.Name, Method: AuthMethodAPIKey, Roles: apiKey.Roles}, nil
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		return Principal{}, errNoCredentials
+	}
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return Principal{}, errors.New("the Authorization header must carry a Bearer token")
+	}
+	claims, err := verifyJWT(strings.TrimSpace(token), a.keys, a.issuer, a.audience, a.now())
+	if err != nil {
+		return Principal{}, err
+	}
+
+	principal := Principal{
+		Subject:    claims.Subject,
+		Method:     AuthMethodBearer,
+		Roles:      claims.Roles,
+		CustomerId: claims.CustomerId,
+		EmployeeId: claims.EmployeeId,
+	}
+	if claims.IssuedAt != 0 {
+		principal.IssuedAt = time.UnixMicro(int64(math.Round(claims.IssuedAt * 1e6)))
+	}
+
+	return principal, nil
+}
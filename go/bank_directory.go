@@ -0,0 +1,138 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// patternBankCode matches a Bankleitzahl, whose first digit identifies one of the clearing areas 1 to 8
+var patternBankCode = regexp.MustCompile(`^[1-8][0-9]{7}$`)
+
+// defaultBankCodes is an excerpt of the Bankleitzahlendatei of the Deutsche Bundesbank with the banks of the examples
+// and some of the largest German banks. The full file is published monthly at https://www.bundesbank.de.
+//
+//go:embed bank_codes_default.txt
+var defaultBankCodes []byte
+
+// bankCodeRecordLength is the length of a record of the Bankleitzahlendatei in bytes
+const bankCodeRecordLength = 168
+
+// Bank is a German bank as listed in the Bankleitzahlendatei
+type Bank struct {
+	// Code is the Bankleitzahl, the 5th to 12th character of the bank's IBANs
+	Code string
+	Name string
+	City string
+	// Bic is empty for the few banks that do not take part in payment transactions
+	Bic string
+}
+
+// BankDirectory looks up German banks by their Bankleitzahl
+type BankDirectory struct {
+	banks map[string]Bank
+}
+
+// DefaultBankDirectory returns the directory of the banks shipped with the server
+func DefaultBankDirectory() (*BankDirectory, error) {
+	return ParseBankDirectory(defaultBankCodes)
+}
+
+// LoadBankDirectory reads a Bankleitzahlendatei in the fixed-width text format of the Deutsche Bundesbank
+func LoadBankDirectory(path string) (*BankDirectory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	directory, err := ParseBankDirectory(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return directory, nil
+}
+
+// ParseBankDirectory decodes a Bankleitzahlendatei. The file is encoded as ISO 8859-1 and has a record of
+// 168 bytes per line. Each Bankleitzahl has one record of the bank itself (Merkmal 1) and may have records
+// of its branches (Merkmal 2); only the former is kept, as branches use the bank's BIC.
+// Records marked as deleted are skipped.
+func ParseBankDirectory(data []byte) (*BankDirectory, error) {
+	directory := &BankDirectory{banks: make(map[string]Bank)}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		record := bytes.TrimRight(scanner.Bytes(), "\r")
+		if len(record) == 0 {
+			continue
+		}
+		if len(record) != bankCodeRecordLength {
+			return nil, fmt.Errorf("line %d has %d bytes, records have %d", line, len(record), bankCodeRecordLength)
+		}
+		if !patternBankCode.Match(record[0:8]) {
+			return nil, fmt.Errorf("line %d does not start with a bank code", line)
+		}
+		if record[8] != '1' || record[158] == 'D' {
+			continue
+		}
+
+		bank := Bank{
+			Code: string(record[0:8]),
+			Name: bankCodeField(record[9:67]),
+			City: bankCodeField(record[72:107]),
+			Bic:  bankCodeField(record[139:150]),
+		}
+		directory.banks[bank.Code] = bank
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(directory.banks) == 0 {
+		return nil, fmt.Errorf("no banks found")
+	}
+
+	return directory, nil
+}
+
+// Lookup returns the bank with the Bankleitzahl code
+func (d *BankDirectory) Lookup(code string) (Bank, bool) {
+	bank, ok := d.banks[code]
+	return bank, ok
+}
+
+// LookupIban returns the bank of a German IBAN
+func (d *BankDirectory) LookupIban(iban string) (Bank, bool) {
+	if len(iban) != ibanLengths["DE"] || !strings.HasPrefix(iban, "DE") {
+		return Bank{}, false
+	}
+
+	return d.Lookup(iban[4:12])
+}
+
+// Len returns the number of banks in the directory
+func (d *BankDirectory) Len() int {
+	return len(d.banks)
+}
+
+// bankCodeField converts a field of a record from ISO 8859-1 to a trimmed string
+func bankCodeField(field []byte) string {
+	runes := make([]rune, len(field))
+	for i, b := range field {
+		runes[i] = rune(b)
+	}
+
+	return strings.TrimSpace(string(runes))
+}
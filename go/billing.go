@@ -0,0 +1,289 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Creditor is the insurer collecting the premiums by SEPA direct debit
+type Creditor struct {
+	// Id is the Gläubiger-Identifikationsnummer
+	Id   string
+	Name string
+	// Iban is the account the premiums are credited to
+	Iban string
+	Bic  string
+}
+
+// Check checks the creditor identifier, IBAN and BIC of the creditor
+func (c Creditor) Check() error {
+	if err := CheckCreditorId(c.Id); err != nil {
+		return err
+	}
+	if c.Name == "" {
+		return errors.New("creditor name is required")
+	}
+	if !patternIban.MatchString(c.Iban) {
+		return fmt.Errorf("creditor IBAN %q must match the pattern %s", c.Iban, patternIban)
+	}
+	if err := checkIban(c.Iban); err != nil {
+		return fmt.Errorf("creditor IBAN %s %w", c.Iban, err)
+	}
+	if !patternBic.MatchString(c.Bic) || !bicMatchesIban(c.Bic, c.Iban) {
+		return fmt.Errorf("creditor BIC %q is invalid or does not belong to the IBAN", c.Bic)
+	}
+
+	return nil
+}
+
+// premiumPeriod is the period an installment of a contract's premium pays for
+type premiumPeriod struct {
+	due time.Time
+	end time.Time
+}
+
+// premiumPeriods returns the periods the premium of a contract is paid for that fall due until the given day.
+// They start with the contract and follow each other by a month, quarter or year, depending on its payment
+// frequency, until its cover ends.
+func premiumPeriods(contract ContractRes, until time.Time) ([]premiumPeriod, error) {
+	start, err := time.Parse(dateLayout, contract.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("startDate %q is not a valid date", contract.StartDate)
+	}
+	end, err := coverEnd(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	months := 12 / installmentsPerYear(contract.PaymentFrequency)
+	periods := make([]premiumPeriod, 0)
+	for i := 0; ; i++ {
+		due := addMonths(start, i*months)
+		if due.After(until) || !due.Before(end) {
+			return periods, nil
+		}
+		period := premiumPeriod{due: due, end: addMonths(start, (i+1)*months).AddDate(0, 0, -1)}
+		if period.end.After(end) {
+			period.end = end
+		}
+		periods = append(periods, period)
+	}
+}
+
+// addMonths adds months to a day. Days the target month does not have, e.g. the 31st, fall on its last day.
+func addMonths(day time.Time, months int) time.Time {
+	first := time.Date(day.Year(), day.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	if day.Day() < last {
+		last = day.Day()
+	}
+
+	return time.Date(first.Year(), first.Month(), last, 0, 0, 0, 0, time.UTC)
+}
+
+// installmentsPerYear returns how many installments the yearly premium is paid in with the payment frequency.
+// Contracts created before payment frequencies were introduced pay yearly.
+func installmentsPerYear(frequency PaymentFrequency) int {
+	switch frequency {
+	case PaymentFrequencyMonthly:
+		return 12
+	case PaymentFrequencyQuarterly:
+		return 4
+	default:
+		return 1
+	}
+}
+
+// newInstallmentPlan splits a yearly premium into the installments of the payment frequency. The surcharge is
+// a share of the yearly premium added to the installments.
+func newInstallmentPlan(rate float32, frequency PaymentFrequency, surcharge float64) InstallmentPlan {
+	perYear := int64(installmentsPerYear(frequency))
+	premium := int64(math.Round(float64(rate) * 100 / float64(perYear)))
+	extra := int64(math.Round(float64(rate) * 100 * surcharge / float64(perYear)))
+
+	return InstallmentPlan{
+		PaymentFrequency:    frequency,
+		InstallmentsPerYear: int32(perYear),
+		InstallmentAmount:   fromCents(premium + extra),
+		Surcharge:           fromCents(extra),
+		YearlyAmount:        fromCents((premium + extra) * perYear),
+	}
+}
+
+// installmentPlans returns the installments of a yearly premium for every payment frequency
+func installmentPlans(rate float32, tariff *Tariff) []InstallmentPlan {
+	plans := make([]InstallmentPlan, 0, len(AllowedPaymentFrequencyEnumValues))
+	for _, frequency := range AllowedPaymentFrequencyEnumValues {
+		plans = append(plans, newInstallmentPlan(rate, frequency, tariff.paymentSurcharge(frequency)))
+	}
+
+	return plans
+}
+
+// priceInstallments sets the installment amount of a contract from its rate and payment frequency, with the
+// surcharge of the tariff version the contract was priced with
+func priceInstallments(contract *ContractRes, rates *RateEngine) error {
+	tariff, err := rates.Tariff(contract.TariffVersion)
+	if err != nil {
+		return err
+	}
+	if contract.PaymentFrequency == "" {
+		contract.PaymentFrequency = PaymentFrequencyYearly
+	}
+
+	plan := newInstallmentPlan(contract.Rate, contract.PaymentFrequency, tariff.paymentSurcharge(contract.PaymentFrequency))
+	contract.InstallmentAmount = plan.InstallmentAmount
+	contract.InstallmentSurcharge = plan.Surcharge
+
+	return nil
+}
+
+// contractInstallment returns the amount and surcharge of the next installments of a contract. Contracts priced
+// before payment frequencies were introduced pay their rate once a year.
+func contractInstallment(contract ContractRes) (float32, float32) {
+	if contract.InstallmentAmount <= 0 {
+		return contract.Rate, 0
+	}
+
+	return contract.InstallmentAmount, contract.InstallmentSurcharge
+}
+
+// contractInvoices returns an invoice for every installment period of a contract. Periods that fell due are
+// invoiced with the amount and status of their installment, later periods with the next installment amount.
+func contractInvoices(contract ContractRes, installments []InstallmentRes) ([]InvoiceRes, error) {
+	end, err := coverEnd(contract)
+	if err != nil {
+		return nil, err
+	}
+	periods, err := premiumPeriods(contract, end)
+	if err != nil {
+		return nil, err
+	}
+
+	byDueDate := make(map[string]InstallmentRes)
+	for _, installment := range installments {
+		byDueDate[installment.DueDate] = installment
+	}
+	frequency := contract.PaymentFrequency
+	if frequency == "" {
+		frequency = PaymentFrequencyYearly
+	}
+	amount, surcharge := contractInstallment(contract)
+
+	invoices := make([]InvoiceRes, 0, len(periods))
+	for i, period := range periods {
+		invoice := InvoiceRes{
+			Number:           fmt.Sprintf("%s-%03d", strings.ToUpper(contract.Id[:8]), i+1),
+			ContractId:       contract.Id,
+			CustomerId:       contract.CustomerId,
+			DueDate:          period.due.Format(dateLayout),
+			PeriodStart:      period.due.Format(dateLayout),
+			PeriodEnd:        period.end.Format(dateLayout),
+			PaymentFrequency: frequency,
+			Surcharge:        surcharge,
+			Amount:           amount,
+			Status:           InstallmentStatusOpen,
+		}
+		if installment, ok := byDueDate[invoice.DueDate]; ok {
+			invoice.InstallmentId = installment.Id
+			invoice.Surcharge = installment.Surcharge
+			invoice.Amount = installment.Amount
+			invoice.Status = installment.Status
+		}
+		invoice.Premium = fromCents(toCents(invoice.Amount) - toCents(invoice.Surcharge))
+		invoices = append(invoices, invoice)
+	}
+
+	return invoices, nil
+}
+
+// toCents converts an amount in euros to cents
+func toCents(amount float32) int64 {
+	return int64(math.Round(float64(amount) * 100))
+}
+
+// fromCents converts an amount in cents to euros
+func fromCents(cents int64) float32 {
+	return float32(cents) / 100
+}
+
+// earliestCollectionDate returns the first date a direct debit submitted on the given day can be collected.
+// SEPA Core direct debits must reach the debtor's bank one TARGET2 business day before the collection date.
+func earliestCollectionDate(day time.Time) time.Time {
+	return nextBusinessDay(day.AddDate(0, 0, 1))
+}
+
+// nextBusinessDay returns day if it is a TARGET2 business day, otherwise the next business day
+func nextBusinessDay(day time.Time) time.Time {
+	for !isBusinessDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return day
+}
+
+// isBusinessDay reports whether TARGET2 is open on the day. It is closed on weekends, New Year's Day,
+// Good Friday, Easter Monday, Labour Day and on Christmas Day and the day after.
+func isBusinessDay(day time.Time) bool {
+	switch day.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+
+	easter := easterSunday(day.Year())
+	holidays := []time.Time{
+		time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, time.UTC),
+		easter.AddDate(0, 0, -2),
+		easter.AddDate(0, 0, 1),
+		time.Date(day.Year(), time.May, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(day.Year(), time.December, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(day.Year(), time.December, 26, 0, 0, 0, 0, time.UTC),
+	}
+	for _, holiday := range holidays {
+		if holiday.Month() == day.Month() && holiday.Day() == day.Day() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// easterSunday computes the date of Easter Sunday in the Gregorian calendar with the anonymous Gregorian algorithm
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// sortInstallments orders installments by due date, then by contract
+func sortInstallments(installments []InstallmentRes) {
+	sort.SliceStable(installments, func(i, j int) bool {
+		if installments[i].DueDate != installments[j].DueDate {
+			return installments[i].DueDate < installments[j].DueDate
+		}
+		return installments[i].ContractId < installments[j].ContractId
+	})
+}
@@ -0,0 +1,189 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testDate parses a date of the api, failing the test if it is invalid
+func testDate(t *testing.T, value string) time.Time {
+	t.Helper()
+	day, err := time.Parse(dateLayout, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return day
+}
+
+func TestPremiumPeriods(t *testing.T) {
+	tests := []struct {
+		name     string
+		contract ContractRes
+		until    string
+		// want holds the due date and the end of every period
+		want    [][2]string
+		wantErr bool
+	}{
+		{
+			name:     "yearly",
+			contract: ContractRes{StartDate: "2026-01-01", EndDate: "2026-12-31", PaymentFrequency: PaymentFrequencyYearly},
+			until:    "2026-06-01",
+			want:     [][2]string{{"2026-01-01", "2026-12-31"}},
+		},
+		{
+			name:     "without payment frequency",
+			contract: ContractRes{StartDate: "2026-01-01", EndDate: "2026-12-31"},
+			until:    "2026-12-31",
+			want:     [][2]string{{"2026-01-01", "2026-12-31"}},
+		},
+		{
+			name:     "quarterly from the end of a month",
+			contract: ContractRes{StartDate: "2026-01-31", EndDate: "2027-01-30", PaymentFrequency: PaymentFrequencyQuarterly},
+			until:    "2027-06-01",
+			want: [][2]string{
+				{"2026-01-31", "2026-04-29"},
+				{"2026-04-30", "2026-07-30"},
+				{"2026-07-31", "2026-10-30"},
+				{"2026-10-31", "2027-01-30"},
+			},
+		},
+		{
+			name:     "monthly until a due date",
+			contract: ContractRes{StartDate: "2026-01-15", EndDate: "2027-01-14", PaymentFrequency: PaymentFrequencyMonthly},
+			until:    "2026-03-15",
+			want: [][2]string{
+				{"2026-01-15", "2026-02-14"},
+				{"2026-02-15", "2026-03-14"},
+				{"2026-03-15", "2026-04-14"},
+			},
+		},
+		{
+			name: "cancelled",
+			contract: ContractRes{StartDate: "2026-01-01", EndDate: "2026-12-31", PaymentFrequency: PaymentFrequencyMonthly,
+				Status: ContractStatusCancelled, CancellationDate: "2026-03-15"},
+			until: "2026-12-31",
+			want: [][2]string{
+				{"2026-01-01", "2026-01-31"},
+				{"2026-02-01", "2026-02-28"},
+				{"2026-03-01", "2026-03-14"},
+			},
+		},
+		{
+			name:     "not due yet",
+			contract: ContractRes{StartDate: "2026-01-01", EndDate: "2026-12-31", PaymentFrequency: PaymentFrequencyMonthly},
+			until:    "2025-12-31",
+			want:     [][2]string{},
+		},
+		{
+			name:     "invalid start date",
+			contract: ContractRes{StartDate: "01.01.2026", EndDate: "2026-12-31"},
+			until:    "2026-12-31",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods, err := premiumPeriods(tt.contract, testDate(t, tt.until))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("premiumPeriods() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := make([][2]string, 0, len(periods))
+			for _, period := range periods {
+				got = append(got, [2]string{period.due.Format(dateLayout), period.end.Format(dateLayout)})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("premiumPeriods() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		day    string
+		months int
+		want   string
+	}{
+		{day: "2026-01-15", months: 0, want: "2026-01-15"},
+		{day: "2026-01-15", months: 1, want: "2026-02-15"},
+		{day: "2026-01-31", months: 1, want: "2026-02-28"},
+		{day: "2024-01-31", months: 1, want: "2024-02-29"},
+		{day: "2026-03-31", months: 1, want: "2026-04-30"},
+		{day: "2026-11-30", months: 3, want: "2027-02-28"},
+		{day: "2026-01-15", months: 12, want: "2027-01-15"},
+		{day: "2026-03-31", months: -1, want: "2026-02-28"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.day, func(t *testing.T) {
+			if got := addMonths(testDate(t, tt.day), tt.months).Format(dateLayout); got != tt.want {
+				t.Errorf("addMonths(%s, %d) = %s, want %s", tt.day, tt.months, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEasterSunday(t *testing.T) {
+	tests := []struct {
+		year int
+		want string
+	}{
+		{year: 2000, want: "2000-04-23"},
+		{year: 2019, want: "2019-04-21"},
+		{year: 2024, want: "2024-03-31"},
+		{year: 2025, want: "2025-04-20"},
+		{year: 2026, want: "2026-04-05"},
+		{year: 2027, want: "2027-03-28"},
+		{year: 2038, want: "2038-04-25"},
+		{year: 2285, want: "2285-03-22"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := easterSunday(tt.year).Format(dateLayout); got != tt.want {
+				t.Errorf("easterSunday(%d) = %s, want %s", tt.year, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		name string
+		day  string
+		want bool
+	}{
+		{name: "New Year's Day", day: "2026-01-01"},
+		{name: "day after New Year's Day", day: "2026-01-02", want: true},
+		{name: "Good Friday", day: "2026-04-03"},
+		{name: "Easter Monday", day: "2026-04-06"},
+		{name: "Tuesday after Easter", day: "2026-04-07", want: true},
+		{name: "Labour Day", day: "2026-05-01"},
+		{name: "German national holiday", day: "2025-10-03", want: true},
+		{name: "Christmas Eve", day: "2026-12-24", want: true},
+		{name: "Christmas Day", day: "2026-12-25"},
+		{name: "day after Christmas", day: "2025-12-26"},
+		{name: "Saturday", day: "2026-10-17"},
+		{name: "Sunday", day: "2026-10-18"},
+		{name: "Monday", day: "2026-10-19", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isBusinessDay(testDate(t, tt.day)); got != tt.want {
+				t.Errorf("isBusinessDay(%s) = %v, want %v", tt.day, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// BlobStore defines a store for the content of uploaded documents. Blobs are addressed by
+// slash separated keys whose last element is the file name presented on download.
+type BlobStore interface {
+	// Put stores the content read from r under key, replacing any previous blob, and returns its size
+	Put(key string, r io.Reader) (int64, error)
+	// Open opens the blob stored under key for reading or returns ErrNotFound. The base name
+	// of the returned file is the last element of key.
+	Open(key string) (*os.File, error)
+	// Delete removes the blob stored under key or returns ErrNotFound
+	Delete(key string) error
+}
+
+// FileBlobStore is a BlobStore keeping every blob as a file below a directory of the local filesystem
+type FileBlobStore struct {
+	dir string
+}
+
+// NewFileBlobStore creates a store below dir, creating the directory if necessary
+func NewFileBlobStore(dir string) (*FileBlobStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &FileBlobStore{dir: dir}, nil
+}
+
+// Put writes the content to a temporary file first, so a failed upload never replaces a stored blob
+func (s *FileBlobStore) Put(key string, r io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return 0, err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(file.Name())
+
+	size, err := io.Copy(file, r)
+	if err != nil {
+		file.Close()
+		return 0, err
+	}
+	if err := file.Close(); err != nil {
+		return 0, err
+	}
+
+	return size, os.Rename(file.Name(), path)
+}
+
+// Open opens the file of the blob stored under key
+func (s *FileBlobStore) Open(key string) (*os.File, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+// Delete removes the file of the blob stored under key and its directory once it is empty
+func (s *FileBlobStore) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ErrNotFound
+		}
+		return err
+	}
+	for dir := filepath.Dir(path); dir != filepath.Clean(s.dir); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// path maps key to a file below the store's directory, rejecting keys that would escape it
+func (s *FileBlobStore) path(key string) (string, error) {
+	elements := strings.Split(key, "/")
+	for _, element := range elements {
+		if element == "" || element == "." || element == ".." || strings.ContainsRune(element, filepath.Separator) {
+			return "", fmt.Errorf("invalid blob key %q", key)
+		}
+	}
+
+	return filepath.Join(append([]string{s.dir}, elements...)...), nil
+}
@@ -0,0 +1,209 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// camt053NamespacePrefix is the namespace of ISO 20022 bank to customer statements without the version,
+// e.g. urn:iso:std:iso:20022:tech:xsd:camt.053.001.02 as used by German banks
+const camt053NamespacePrefix = "urn:iso:std:iso:20022:tech:xsd:camt.053.001."
+
+// camt053Document is an ISO 20022 bank to customer statement. The camt053 types mirror the parts of the schema
+// needed for reconciliation and accept both version 02 and the later versions.
+type camt053Document struct {
+	XMLName    xml.Name           `xml:"Document"`
+	Statements []camt053Statement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camt053Statement struct {
+	Id               string         `xml:"Id"`
+	CreationDateTime string         `xml:"CreDtTm"`
+	Iban             string         `xml:"Acct>Id>IBAN"`
+	Entries          []camt053Entry `xml:"Ntry"`
+}
+
+type camt053Entry struct {
+	Amount      camt053Amount         `xml:"Amt"`
+	CreditDebit string                `xml:"CdtDbtInd"`
+	Status      camt053Status         `xml:"Sts"`
+	BookingDate camt053Date           `xml:"BookgDt"`
+	ValueDate   camt053Date           `xml:"ValDt"`
+	Details     []camt053EntryDetails `xml:"NtryDtls"`
+}
+
+type camt053EntryDetails struct {
+	PaymentInformationId string               `xml:"Btch>PmtInfId"`
+	Transactions         []camt053Transaction `xml:"TxDtls"`
+}
+
+type camt053Transaction struct {
+	EndToEndId           string        `xml:"Refs>EndToEndId"`
+	MandateId            string        `xml:"Refs>MndtId"`
+	PaymentInformationId string        `xml:"Refs>PmtInfId"`
+	Amount               camt053Amount `xml:"Amt"`
+	TransactionAmount    camt053Amount `xml:"AmtDtls>TxAmt>Amt"`
+	CreditDebit          string        `xml:"CdtDbtInd"`
+	DebtorName           string        `xml:"RltdPties>Dbtr>Nm"`
+	DebtorPartyName      string        `xml:"RltdPties>Dbtr>Pty>Nm"`
+	DebtorIban           string        `xml:"RltdPties>DbtrAcct>Id>IBAN"`
+	Remittance           []string      `xml:"RmtInf>Ustrd"`
+	ReturnReason         string        `xml:"RtrInf>Rsn>Cd"`
+}
+
+type camt053Amount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// camt053Status is the status code of an entry, given directly in version 02 and as Cd in later versions
+type camt053Status struct {
+	Code string `xml:"Cd"`
+	Text string `xml:",chardata"`
+}
+
+// camt053Date is a date, or a date and time in ISO 8601 format
+type camt053Date struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+// day returns the date, or the date part of the date and time
+func (d camt053Date) day() string {
+	if d.Date != "" || len(d.DateTime) < len(dateLayout) {
+		return d.Date
+	}
+
+	return d.DateTime[:len(dateLayout)]
+}
+
+// bankStatement is a statement read from a camt.053 file with the entries relevant for reconciliation
+type bankStatement struct {
+	id        string
+	iban      string
+	createdAt string
+	entries   []StatementEntryRes
+}
+
+// parseCamt053 reads the statements of a camt.053 file. Every booked transaction becomes an entry; pending entries
+// and entries in currencies other than euros are skipped. An entry without transaction details, such as a batch
+// booked as a whole, becomes a single entry.
+func parseCamt053(r io.Reader) ([]bankStatement, error) {
+	var document camt053Document
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("not a camt.053 statement: %w", err)
+	}
+	if !strings.HasPrefix(document.XMLName.Space, camt053NamespacePrefix) {
+		return nil, fmt.Errorf("not a camt.053 statement, the document has the namespace %q", document.XMLName.Space)
+	}
+	if len(document.Statements) == 0 {
+		return nil, errors.New("the document contains no statement")
+	}
+
+	statements := make([]bankStatement, 0, len(document.Statements))
+	for _, stmt := range document.Statements {
+		if stmt.Id == "" || stmt.Iban == "" {
+			return nil, errors.New("every statement needs an id and the IBAN of its account")
+		}
+		statement := bankStatement{id: stmt.Id, iban: stmt.Iban, createdAt: stmt.CreationDateTime}
+
+		for i, entry := range stmt.Entries {
+			if status := strings.TrimSpace(entry.Status.Code + entry.Status.Text); status != "BOOK" {
+				continue
+			}
+			if entry.Amount.Currency != "EUR" {
+				continue
+			}
+			template := StatementEntryRes{
+				BookingDate: entry.BookingDate.day(),
+				ValueDate:   entry.ValueDate.day(),
+				CreditDebit: CreditDebitIndicator(entry.CreditDebit),
+			}
+			if !template.CreditDebit.IsValid() {
+				return nil, fmt.Errorf("entry %d of statement %s has the credit debit indicator %q", i+1, stmt.Id, entry.CreditDebit)
+			}
+			if template.BookingDate == "" {
+				return nil, fmt.Errorf("entry %d of statement %s has no booking date", i+1, stmt.Id)
+			}
+
+			transactions := make([]camt053Transaction, 0)
+			for _, details := range entry.Details {
+				if details.PaymentInformationId != "" {
+					template.PaymentInformationId = details.PaymentInformationId
+				}
+				transactions = append(transactions, details.Transactions...)
+			}
+			if len(transactions) == 0 {
+				amount, err := parseCamt053Amount(entry.Amount.Value)
+				if err != nil {
+					return nil, fmt.Errorf("entry %d of statement %s: %w", i+1, stmt.Id, err)
+				}
+				template.Amount = amount
+				statement.entries = append(statement.entries, template)
+				continue
+			}
+
+			for _, transaction := range transactions {
+				statementEntry := template
+				value := entry.Amount.Value
+				if len(transactions) > 1 {
+					value = transaction.Amount.Value + transaction.TransactionAmount.Value
+				}
+				amount, err := parseCamt053Amount(value)
+				if err != nil {
+					return nil, fmt.Errorf("entry %d of statement %s: %w", i+1, stmt.Id, err)
+				}
+				statementEntry.Amount = amount
+				if indicator := CreditDebitIndicator(transaction.CreditDebit); indicator.IsValid() {
+					statementEntry.CreditDebit = indicator
+				}
+				statementEntry.EndToEndId = camt053Reference(transaction.EndToEndId)
+				statementEntry.MandateReference = transaction.MandateId
+				if transaction.PaymentInformationId != "" {
+					statementEntry.PaymentInformationId = transaction.PaymentInformationId
+				}
+				statementEntry.CounterpartyName = transaction.DebtorName + transaction.DebtorPartyName
+				statementEntry.CounterpartyIban = transaction.DebtorIban
+				statementEntry.RemittanceInformation = strings.Join(transaction.Remittance, " ")
+				statementEntry.ReturnReason = transaction.ReturnReason
+				statement.entries = append(statement.entries, statementEntry)
+			}
+		}
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+// parseCamt053Amount parses a decimal amount in euros
+func parseCamt053Amount(value string) (float32, error) {
+	amount, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || amount <= 0 {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+
+	return float32(math.Round(amount*100) / 100), nil
+}
+
+// camt053Reference returns a reference, or an empty string for the placeholder NOTPROVIDED used by banks
+func camt053Reference(reference string) string {
+	if reference == "NOTPROVIDED" {
+		return ""
+	}
+
+	return reference
+}
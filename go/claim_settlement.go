@@ -0,0 +1,86 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// coverEnd returns the last day the contract covers treatments: its end date, or the day
+// before a cancellation takes effect
+func coverEnd(contract ContractRes) (time.Time, error) {
+	end, err := time.Parse(dateLayout, contract.EndDate)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if contract.Status == ContractStatusCancelled && contract.CancellationDate != "" {
+		cancelled, err := time.Parse(dateLayout, contract.CancellationDate)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if cancelled.AddDate(0, 0, -1).Before(end) {
+			end = cancelled.AddDate(0, 0, -1)
+		}
+	}
+
+	return end, nil
+}
+
+// checkCover returns an error if the contract did not cover a treatment on the given date
+func checkCover(contract ContractRes, treatment time.Time) error {
+	start, err := time.Parse(dateLayout, contract.StartDate)
+	if err != nil {
+		return err
+	}
+	end, err := coverEnd(contract)
+	if err != nil {
+		return err
+	}
+	if treatment.Before(start) || treatment.After(end) {
+		return fmt.Errorf("treatmentDate %s lies outside of the cover of contract %s from %s to %s",
+			treatment.Format(dateLayout), contract.Id, contract.StartDate, end.Format(dateLayout))
+	}
+
+	return nil
+}
+
+// coverageYearStart returns the first day of the contract year containing day. Contract years start
+// on the anniversaries of the contract's start date.
+func coverageYearStart(contract ContractRes, day time.Time) (time.Time, error) {
+	start, err := time.Parse(dateLayout, contract.StartDate)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	years := day.Year() - start.Year()
+	if start.AddDate(years, 0, 0).After(day) {
+		years--
+	}
+
+	return start.AddDate(years, 0, 0), nil
+}
+
+// settleClaim computes the payable amount of a claim. The tariff's deductible and co-payment are
+// subtracted from the invoice amount, and the result is limited to the coverage the contract has
+// left in the contract year after paying the amounts in paid.
+func settleClaim(claim *ClaimRes, tariff *Tariff, coverage float32, paid float32) {
+	invoice := float64(claim.InvoiceAmount)
+	deductible := math.Min(invoice, tariff.Deductible)
+	coPayment := (invoice - deductible) * tariff.CoPayment
+	remaining := math.Max(0, float64(coverage-paid))
+	payable := math.Min(invoice-deductible-coPayment, remaining)
+
+	claim.Deductible = float32(math.Round(deductible*100) / 100)
+	claim.CoPayment = float32(math.Round(coPayment*100) / 100)
+	claim.PayableAmount = float32(math.Round(payable*100) / 100)
+	claim.RemainingCoverage = float32(math.Round((remaining-payable)*100) / 100)
+}
@@ -0,0 +1,113 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// claimEarlyTreatmentDays is the number of days after the contract start in which every claim is reviewed
+	claimEarlyTreatmentDays = 30
+	// claimFrequencyWindowDays and claimFrequencyLimit hold back a claim if the contract has at least
+	// claimFrequencyLimit claims, including it, with treatments within claimFrequencyWindowDays of each other
+	claimFrequencyWindowDays = 30
+	claimFrequencyLimit      = 3
+)
+
+// claimTransitions lists the statuses a claim may change to from each status. Claims that need no review
+// are approved by CreateClaim on submission, see approveClaim; paid and rejected claims are final.
+var claimTransitions = map[ClaimStatus][]ClaimStatus{
+	ClaimStatusSubmitted:         {ClaimStatusInReview},
+	ClaimStatusInReview:          {ClaimStatusApproved, ClaimStatusPartiallyApproved, ClaimStatusRejected},
+	ClaimStatusApproved:          {ClaimStatusPaid},
+	ClaimStatusPartiallyApproved: {ClaimStatusPaid},
+}
+
+// claimDecisions are the statuses an adjuster may decide a claim in review to change to
+var claimDecisions = []ClaimStatus{ClaimStatusApproved, ClaimStatusPartiallyApproved, ClaimStatusRejected}
+
+// transitionClaim changes the status of the claim and records the transition in its history,
+// or returns an error wrapping ErrInvalidTransition
+func transitionClaim(claim *ClaimRes, to ClaimStatus, employeeId string, reason string, at time.Time) error {
+	allowed := false
+	for _, status := range claimTransitions[claim.Status] {
+		if status == to {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return fmt.Errorf("%w: claim %s is %s and cannot become %s", ErrInvalidTransition, claim.Id, claim.Status, to)
+	}
+	recordClaimTransition(claim, to, employeeId, reason, at)
+
+	return nil
+}
+
+// approveClaim approves a submitted claim that needs no review. Only CreateClaim approves claims without an
+// adjuster, so the transition is not part of claimTransitions.
+func approveClaim(claim *ClaimRes, at time.Time) error {
+	if claim.Status != ClaimStatusSubmitted || len(claim.ReviewReasons) > 0 {
+		return fmt.Errorf("%w: claim %s must be reviewed by an adjuster", ErrInvalidTransition, claim.Id)
+	}
+	recordClaimTransition(claim, ClaimStatusApproved, "", "no review required", at)
+
+	return nil
+}
+
+// recordClaimTransition changes the status of the claim and records the transition in its history
+func recordClaimTransition(claim *ClaimRes, to ClaimStatus, employeeId string, reason string, at time.Time) {
+	claim.History = append(claim.History, ClaimTransition{
+		At:         at.UTC().Format(time.RFC3339),
+		From:       claim.Status,
+		To:         to,
+		EmployeeId: employeeId,
+		Reason:     reason,
+	})
+	claim.Status = to
+}
+
+// claimReviewReasons returns why a new claim must be reviewed by an adjuster before it is paid, or nothing
+// if it can be paid automatically. others are the contract's previous claims.
+func claimReviewReasons(claim ClaimRes, contract ContractRes, others []ClaimRes, threshold float32) []string {
+	reasons := make([]string, 0)
+	if claim.InvoiceAmount > threshold {
+		reasons = append(reasons, fmt.Sprintf("invoice amount exceeds the review threshold of %.2f", threshold))
+	}
+
+	treatment, _ := time.Parse(dateLayout, claim.TreatmentDate)
+	if start, err := time.Parse(dateLayout, contract.StartDate); err == nil && treatment.Before(start.AddDate(0, 0, claimEarlyTreatmentDays)) {
+		reasons = append(reasons, fmt.Sprintf("treatment within %d days of the contract start", claimEarlyTreatmentDays))
+	}
+
+	nearby := 1
+	for _, other := range others {
+		if other.Status == ClaimStatusRejected {
+			continue
+		}
+		if other.TreatmentDate == claim.TreatmentDate && other.InvoiceAmount == claim.InvoiceAmount {
+			reasons = append(reasons, fmt.Sprintf("possible duplicate of claim %s", other.Id))
+		}
+		otherTreatment, err := time.Parse(dateLayout, other.TreatmentDate)
+		if err != nil {
+			continue
+		}
+		days := treatment.Sub(otherTreatment).Hours() / 24
+		if days > -claimFrequencyWindowDays && days < claimFrequencyWindowDays {
+			nearby++
+		}
+	}
+	if nearby >= claimFrequencyLimit {
+		reasons = append(reasons, fmt.Sprintf("%d claims with treatments within %d days", nearby, claimFrequencyWindowDays))
+	}
+
+	return reasons
+}
@@ -0,0 +1,85 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// contractVersionOf records the priced attributes of a contract as a version effective from effectiveDate
+func contractVersionOf(contract ContractRes, zipCode float32, effectiveDate string, createdAt time.Time) ContractVersion {
+	return ContractVersion{
+		ContractId:    contract.Id,
+		Version:       contract.Version,
+		EffectiveDate: effectiveDate,
+		CreatedAt:     createdAt.UTC().Format(time.RFC3339),
+		Coverage:      contract.Coverage,
+		Neutered:      contract.Neutered,
+		Weight:        contract.Weight,
+		Environment:   contract.Environment,
+		ZipCode:       zipCode,
+		Rate:          contract.Rate,
+		TariffVersion: contract.TariffVersion,
+	}
+}
+
+// applyAmendment changes the contract and the customer's address as requested by the amendment
+func applyAmendment(contract *ContractRes, customer *CustomerRes, amendment ContractAmendmentReq) error {
+	if amendment.Coverage == nil && amendment.Neutered == nil && amendment.Weight == nil &&
+		amendment.Environment == nil && amendment.Address == nil {
+		return errors.New("the amendment does not change anything")
+	}
+
+	if amendment.Coverage != nil {
+		contract.Coverage = *amendment.Coverage
+	}
+	if amendment.Neutered != nil {
+		contract.Neutered = *amendment.Neutered
+	}
+	if amendment.Weight != nil {
+		contract.Weight = *amendment.Weight
+	}
+	if amendment.Environment != nil {
+		contract.Environment = *amendment.Environment
+	}
+	if amendment.Address != nil {
+		address := *amendment.Address
+		address.Id = customer.Address.Id
+		customer.Address = address
+	}
+
+	return nil
+}
+
+// termStart returns the first day of the contract's current term: its start date,
+// or the day after the previous end date of its last renewal
+func termStart(contract ContractRes) (time.Time, error) {
+	if len(contract.Renewals) == 0 {
+		return time.Parse(dateLayout, contract.StartDate)
+	}
+	previousEnd, err := time.Parse(dateLayout, contract.Renewals[len(contract.Renewals)-1].PreviousEndDate)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return previousEnd.AddDate(0, 0, 1), nil
+}
+
+// proRataAdjustment returns the premium difference between oldRate and newRate for the days from
+// effective to end of the term starting at start. Both bounds are inclusive.
+func proRataAdjustment(oldRate float32, newRate float32, start time.Time, effective time.Time, end time.Time) float32 {
+	termDays := end.Sub(start).Hours()/24 + 1
+	remainingDays := end.Sub(effective).Hours()/24 + 1
+	adjustment := float64(newRate-oldRate) * remainingDays / termDays
+
+	return float32(math.Round(adjustment*100) / 100)
+}
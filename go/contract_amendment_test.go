@@ -0,0 +1,40 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import "testing"
+
+func TestProRataAdjustment(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldRate   float32
+		newRate   float32
+		start     string
+		effective string
+		end       string
+		want      float32
+	}{
+		{name: "from the start of the term", oldRate: 100, newRate: 200, start: "2026-01-01", effective: "2026-01-01", end: "2026-12-31", want: 100},
+		{name: "second half of the term", oldRate: 100, newRate: 200, start: "2026-01-01", effective: "2026-07-02", end: "2026-12-31", want: 50.14},
+		{name: "lower rate", oldRate: 200, newRate: 100, start: "2026-01-01", effective: "2026-07-02", end: "2026-12-31", want: -50.14},
+		{name: "leap year", oldRate: 100, newRate: 200, start: "2024-01-01", effective: "2024-07-01", end: "2024-12-31", want: 50.27},
+		{name: "last day of the term", oldRate: 100, newRate: 200, start: "2026-01-01", effective: "2026-12-31", end: "2026-12-31", want: 0.27},
+		{name: "term not starting in January", oldRate: 73, newRate: 109.5, start: "2026-03-01", effective: "2027-02-18", end: "2027-02-28", want: 1.1},
+		{name: "same rate", oldRate: 100, newRate: 100, start: "2026-01-01", effective: "2026-07-02", end: "2026-12-31", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := proRataAdjustment(tt.oldRate, tt.newRate, testDate(t, tt.start), testDate(t, tt.effective), testDate(t, tt.end))
+			if got != tt.want {
+				t.Errorf("proRataAdjustment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidTransition is returned when a contract or claim cannot change from its current status to the requested one
+	ErrInvalidTransition = errors.New("invalid status transition")
+)
+
+// contractTransitions lists the statuses a contract may change to from each status.
+// Cancelled and expired contracts are final, except that a cancellation may be reverted
+// by reinstating the contract before it takes effect, which restores the status it had before.
+// Drafts expire if they are not activated before their end date.
+var contractTransitions = map[ContractStatus][]ContractStatus{
+	ContractStatusDraft:     {ContractStatusActive, ContractStatusCancelled, ContractStatusExpired},
+	ContractStatusActive:    {ContractStatusSuspended, ContractStatusCancelled, ContractStatusExpired},
+	ContractStatusSuspended: {ContractStatusActive, ContractStatusCancelled, ContractStatusExpired},
+	ContractStatusCancelled: {ContractStatusDraft, ContractStatusActive, ContractStatusSuspended},
+}
+
+// canTransition reports whether a contract in status from may change to status to
+func canTransition(from ContractStatus, to ContractStatus) bool {
+	for _, allowed := range contractTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// transitionContract changes the status of the contract or returns an error wrapping ErrInvalidTransition
+func transitionContract(contract *ContractRes, to ContractStatus) error {
+	if !canTransition(contract.Status, to) {
+		return fmt.Errorf("%w: contract %s is %s and cannot become %s", ErrInvalidTransition, contract.Id, contract.Status, to)
+	}
+	contract.Status = to
+
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// ContractScheduler expires contracts whose end date has passed and renews those flagged for automatic
+// renewal. A run only depends on the stored contracts and the current date, so repeating it, e.g. after
+// a restart, does not expire or renew a contract twice.
+type ContractScheduler struct {
+	repo     Repository
+	rates    *RateEngine
+	interval time.Duration
+	now      func() time.Time
+	// mu is the lock of the ContractAPIService, taken while a contract is expired or renewed
+	mu *sync.Mutex
+}
+
+// ContractSchedulerOption for how the scheduler is set up.
+type ContractSchedulerOption func(*ContractScheduler)
+
+// WithContractSchedulerClock injects the clock the scheduler derives the current date from
+func WithContractSchedulerClock(now func() time.Time) ContractSchedulerOption {
+	return func(s *ContractScheduler) {
+		s.now = now
+	}
+}
+
+// WithContractSchedulerInterval sets the time between two runs, one day by default
+func WithContractSchedulerInterval(interval time.Duration) ContractSchedulerOption {
+	return func(s *ContractScheduler) {
+		s.interval = interval
+	}
+}
+
+// NewContractScheduler creates a scheduler processing the contracts of repo. contracts is the lock the
+// ContractAPIService changes contracts under.
+func NewContractScheduler(repo Repository, rates *RateEngine, contracts *sync.Mutex, opts ...ContractSchedulerOption) *ContractScheduler {
+	scheduler := &ContractScheduler{
+		repo:     repo,
+		rates:    rates,
+		interval: 24 * time.Hour,
+		now:      time.Now,
+		mu:       contracts,
+	}
+
+	for _, opt := range opts {
+		opt(scheduler)
+	}
+
+	return scheduler
+}
+
+// Run processes the due contracts right away and then once per interval until ctx is done
+func (s *ContractScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		expired, renewed, err := s.ProcessDue(ctx)
+		if err != nil {
+			log.Printf("Processing due contracts failed: %v", err)
+		} else if expired > 0 || renewed > 0 {
+			log.Printf("Expired %d and renewed %d contracts", expired, renewed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDue expires or renews every draft, active or suspended contract whose end date lies before today.
+// Only active contracts are renewed; a contract that cannot be renewed is logged and retried on the next run.
+// Drafts that were never activated expire, so they can no longer be activated after their term.
+func (s *ContractScheduler) ProcessDue(ctx context.Context) (expired int, renewed int, err error) {
+	contracts, err := s.repo.ListContracts(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	today := s.now().Format(dateLayout)
+	for _, listed := range contracts {
+		if listed.EndDate >= today {
+			continue
+		}
+
+		status, err := s.process(ctx, listed.Id, today)
+		if err != nil {
+			return expired, renewed, err
+		}
+		switch status {
+		case ContractStatusExpired:
+			expired++
+		case ContractStatusActive:
+			renewed++
+		}
+	}
+
+	return expired, renewed, nil
+}
+
+// process expires or renews a contract whose end date lies before today. The contract is loaded again under
+// the lock shared with the ContractAPIService, so changes made since it was listed are kept. It returns the
+// new status of the contract, or an empty status if the contract was left as it is.
+func (s *ContractScheduler) process(ctx context.Context, contractId string, today string) (ContractStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contract, err := s.repo.GetContract(ctx, contractId)
+	if err != nil {
+		return "", fmt.Errorf("contract %s: %w", contractId, err)
+	}
+	if contract.EndDate >= today {
+		return "", nil
+	}
+
+	switch {
+	case contract.Status == ContractStatusActive && contract.AutoRenew:
+		if err := s.renew(ctx, &contract, today); err != nil {
+			log.Printf("Renewing contract %s failed: %v", contract.Id, err)
+			return "", nil
+		}
+	case contract.Status == ContractStatusDraft || contract.Status == ContractStatusActive || contract.Status == ContractStatusSuspended:
+		if err := transitionContract(&contract, ContractStatusExpired); err != nil {
+			return "", err
+		}
+	default:
+		return "", nil
+	}
+	contract.Modified = auditStamp(ctx, s.now())
+
+	if err := s.repo.UpdateContract(ctx, contract); err != nil {
+		return "", err
+	}
+
+	return contract.Status, nil
+}
+
+// renew extends the contract year by year until it covers today. The premium of every new year is
+// calculated with the tariff valid at its start and the cat's age on that day, and recorded as a new
+// version of the contract effective from that day. Versions stored by an earlier run that could not update
+// the contract are taken as they are, so the run can be repeated.
+func (s *ContractScheduler) renew(ctx context.Context, contract *ContractRes, today string) error {
+	customer, err := s.repo.GetCustomer(ctx, contract.CustomerId)
+	if err != nil {
+		return fmt.Errorf("customer %s: %w", contract.CustomerId, err)
+	}
+	versions, err := s.repo.ListContractVersions(ctx, contract.Id)
+	if err != nil {
+		return err
+	}
+	stored := make(map[int32]bool, len(versions))
+	for _, version := range versions {
+		stored[version.Version] = true
+	}
+	// Contracts created before versions were recorded get their current state stored as version 1 first
+	if len(versions) == 0 {
+		contract.Version = 1
+		if err := s.repo.CreateContractVersion(ctx, contractVersionOf(*contract, customer.Address.ZipCode, contract.StartDate, s.now())); err != nil {
+			return err
+		}
+	}
+
+	for contract.EndDate < today {
+		end, err := time.Parse(dateLayout, contract.EndDate)
+		if err != nil {
+			return fmt.Errorf("endDate %q is not a valid date", contract.EndDate)
+		}
+		calculation, err := s.rates.CalculateOn(rateRequestFor(*contract, customer), end.AddDate(0, 0, 1))
+		if err != nil {
+			return err
+		}
+
+		renewal := ContractRenewal{
+			RenewedAt:       s.now().UTC().Format(time.RFC3339),
+			PreviousEndDate: contract.EndDate,
+			EndDate:         end.AddDate(1, 0, 0).Format(dateLayout),
+			PreviousRate:    contract.Rate,
+			Rate:            float32(calculation.Rate),
+			TariffVersion:   calculation.TariffVersion,
+		}
+		contract.Renewals = append(contract.Renewals, renewal)
+		contract.EndDate = renewal.EndDate
+		contract.Rate = renewal.Rate
+		contract.TariffVersion = renewal.TariffVersion
+		contract.Version++
+		if stored[contract.Version] {
+			continue
+		}
+
+		version := contractVersionOf(*contract, customer.Address.ZipCode, end.AddDate(0, 0, 1).Format(dateLayout), s.now())
+		version.Renewal = true
+		if err := s.repo.CreateContractVersion(ctx, version); err != nil {
+			return err
+		}
+	}
+
+	return priceInstallments(contract, s.rates)
+}
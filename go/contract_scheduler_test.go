@@ -0,0 +1,116 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestContractSchedulerProcessDue(t *testing.T) {
+	tests := []struct {
+		name string
+		// versions are stored before the run, e.g. by an earlier run that failed to update the contract
+		versions     []int32
+		status       ContractStatus
+		autoRenew    bool
+		wantStatus   ContractStatus
+		wantEndDate  string
+		wantVersions int
+		wantExpired  int
+		wantRenewed  int
+	}{
+		{name: "renewal", versions: []int32{1}, status: ContractStatusActive, autoRenew: true, wantStatus: ContractStatusActive, wantEndDate: "2026-12-31", wantVersions: 2, wantRenewed: 1},
+		{name: "renewal version already stored", versions: []int32{1, 2}, status: ContractStatusActive, autoRenew: true, wantStatus: ContractStatusActive, wantEndDate: "2026-12-31", wantVersions: 2, wantRenewed: 1},
+		{name: "contract without versions", status: ContractStatusActive, autoRenew: true, wantStatus: ContractStatusActive, wantEndDate: "2026-12-31", wantVersions: 2, wantRenewed: 1},
+		{name: "expiry", versions: []int32{1}, status: ContractStatusActive, wantStatus: ContractStatusExpired, wantEndDate: "2025-12-31", wantVersions: 1, wantExpired: 1},
+		{name: "suspended contract expires", versions: []int32{1}, status: ContractStatusSuspended, autoRenew: true, wantStatus: ContractStatusExpired, wantEndDate: "2025-12-31", wantVersions: 1, wantExpired: 1},
+		{name: "cancelled contract is kept", versions: []int32{1}, status: ContractStatusCancelled, autoRenew: true, wantStatus: ContractStatusCancelled, wantEndDate: "2025-12-31", wantVersions: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := NewRepository(NewMemoryStore())
+			rates, err := NewRateEngine(DefaultTariffs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			customer := CustomerRes{
+				Id:          "customer",
+				Address:     Address{Id: "address", ZipCode: 10115},
+				BankDetails: BankDetails{Id: "bankDetails"},
+			}
+			if err := repo.CreateCustomer(ctx, customer); err != nil {
+				t.Fatal(err)
+			}
+			contract := ContractRes{
+				Id:            "contract",
+				StartDate:     "2025-01-01",
+				EndDate:       "2025-12-31",
+				Coverage:      5000,
+				Breed:         "Siamese",
+				Color:         "Black",
+				BirthDate:     "2020-01-01",
+				Personality:   "Playful",
+				Environment:   "Indoor",
+				Weight:        4000,
+				CustomerId:    customer.Id,
+				Status:        tt.status,
+				AutoRenew:     tt.autoRenew,
+				Rate:          120,
+				TariffVersion: "2024-04",
+				Version:       1,
+			}
+			if err := repo.CreateContract(ctx, contract); err != nil {
+				t.Fatal(err)
+			}
+			for _, number := range tt.versions {
+				version := contractVersionOf(contract, customer.Address.ZipCode, contract.StartDate, time.Now())
+				version.Version = number
+				if err := repo.CreateContractVersion(ctx, version); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			scheduler := NewContractScheduler(repo, rates, &sync.Mutex{}, WithContractSchedulerClock(func() time.Time {
+				return time.Date(2026, 1, 15, 8, 0, 0, 0, time.UTC)
+			}))
+			for run := 1; run <= 2; run++ {
+				expired, renewed, err := scheduler.ProcessDue(ctx)
+				if err != nil {
+					t.Fatalf("run %d: ProcessDue() error = %v", run, err)
+				}
+				if run == 1 && (expired != tt.wantExpired || renewed != tt.wantRenewed) {
+					t.Errorf("run %d: expired %d and renewed %d, want %d and %d", run, expired, renewed, tt.wantExpired, tt.wantRenewed)
+				}
+				if run == 2 && (expired != 0 || renewed != 0) {
+					t.Errorf("run %d: expired %d and renewed %d again", run, expired, renewed)
+				}
+			}
+
+			stored, err := repo.GetContract(ctx, contract.Id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stored.Status != tt.wantStatus || stored.EndDate != tt.wantEndDate {
+				t.Errorf("contract is %s until %s, want %s until %s", stored.Status, stored.EndDate, tt.wantStatus, tt.wantEndDate)
+			}
+			versions, err := repo.ListContractVersions(ctx, contract.Id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(versions) != tt.wantVersions || int(stored.Version) != tt.wantVersions {
+				t.Errorf("%d versions stored and contract at version %d, want %d", len(versions), stored.Version, tt.wantVersions)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+//go:embed dunning_default.json
+var defaultDunningJSON []byte
+
+// formalNoticePeriod is the minimum number of days a formal notice according to §38 Abs. 1 VVG gives the
+// customer to pay before the cover may be suspended
+const formalNoticePeriod = 14
+
+// DunningPolicy holds the stages the overdue premiums of returned direct debits are dunned with
+type DunningPolicy struct {
+	// FirstNoticeAfter is the number of days between the return of a direct debit and the notice of the first stage
+	FirstNoticeAfter int `json:"firstNoticeAfter"`
+	// Stages are reached one after the other, each the day after the payment period of the previous one ended
+	Stages []DunningLevel `json:"stages"`
+}
+
+// DunningLevel configures a stage of the dunning process
+type DunningLevel struct {
+	Stage DunningStage `json:"stage"`
+	// Fee is charged with the notice of the stage
+	Fee float64 `json:"fee"`
+	// PaymentPeriod is the number of days the notice gives the customer to pay before the next stage is reached
+	PaymentPeriod int `json:"paymentPeriod"`
+}
+
+// ParseDunningPolicy decodes and validates a dunning policy in its JSON representation
+func ParseDunningPolicy(data []byte) (DunningPolicy, error) {
+	policy := DunningPolicy{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&policy); err != nil {
+		return DunningPolicy{}, err
+	}
+	if err := policy.Validate(); err != nil {
+		return DunningPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// DefaultDunningPolicy returns the dunning policy shipped with the server
+func DefaultDunningPolicy() (DunningPolicy, error) {
+	policy, err := ParseDunningPolicy(defaultDunningJSON)
+	if err != nil {
+		return DunningPolicy{}, fmt.Errorf("invalid default dunning policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// LoadDunningPolicy reads a dunning policy from a JSON file
+func LoadDunningPolicy(path string) (DunningPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DunningPolicy{}, err
+	}
+	policy, err := ParseDunningPolicy(data)
+	if err != nil {
+		return DunningPolicy{}, fmt.Errorf("dunning policy %s: %w", path, err)
+	}
+
+	return policy, nil
+}
+
+// Validate checks that the stages are ordered as the process requires. The cover may only be suspended
+// as the last stage, directly after a formal notice giving the customer at least two weeks to pay.
+func (p DunningPolicy) Validate() error {
+	if len(p.Stages) == 0 {
+		return errors.New("dunning policy needs at least one stage")
+	}
+	if p.FirstNoticeAfter < 0 {
+		return errors.New("dunning policy firstNoticeAfter must not be negative")
+	}
+
+	order := make(map[DunningStage]int, len(AllowedDunningStageEnumValues))
+	for i, stage := range AllowedDunningStageEnumValues {
+		order[stage] = i
+	}
+	for i, level := range p.Stages {
+		if !level.Stage.IsValid() {
+			return fmt.Errorf("dunning stage %d has the unknown stage %q", i, level.Stage)
+		}
+		if i > 0 && order[level.Stage] <= order[p.Stages[i-1].Stage] {
+			return fmt.Errorf("dunning stages must be ordered as %v and must not repeat", AllowedDunningStageEnumValues)
+		}
+		if level.Fee < 0 || level.PaymentPeriod < 0 {
+			return fmt.Errorf("dunning stage %s must not have a negative fee or payment period", level.Stage)
+		}
+		if i < len(p.Stages)-1 && level.PaymentPeriod == 0 {
+			return fmt.Errorf("dunning stage %s needs a payment period before the next stage", level.Stage)
+		}
+		if level.Stage == DunningStageSuspension {
+			if i == 0 || p.Stages[i-1].Stage != DunningStageFormalNotice {
+				return errors.New("the cover can only be suspended directly after a formal notice")
+			}
+			if p.Stages[i-1].PaymentPeriod < formalNoticePeriod {
+				return fmt.Errorf("a formal notice must give at least %d days to pay", formalNoticePeriod)
+			}
+		}
+	}
+
+	return nil
+}
+
+// openDunningCase opens a dunning case for the returned installments of a contract
+func (p DunningPolicy) openDunningCase(contract ContractRes, openedOn time.Time) DunningCaseRes {
+	return DunningCaseRes{
+		Id:             newId(),
+		ContractId:     contract.Id,
+		CustomerId:     contract.CustomerId,
+		Status:         DunningCaseStatusOpen,
+		InstallmentIds: []string{},
+		OpenedOn:       openedOn.Format(dateLayout),
+		NextStage:      p.Stages[0].Stage,
+		NextStageOn:    openedOn.AddDate(0, 0, p.FirstNoticeAfter).Format(dateLayout),
+	}
+}
+
+// escalate issues the notice of the next stage if it is due on the given day and reports whether it did.
+// The date of the stage after it is left open until the notice was sent, see sent.
+func (p DunningPolicy) escalate(dunningCase *DunningCaseRes, today time.Time) bool {
+	index := len(dunningCase.Notices)
+	if index >= len(p.Stages) || dunningCase.NextStageOn == "" || dunningCase.NextStageOn > today.Format(dateLayout) {
+		return false
+	}
+
+	level := p.Stages[index]
+	fee := fromCents(toCents(float32(level.Fee)))
+	notice := DunningNotice{
+		Stage:    level.Stage,
+		IssuedOn: today.Format(dateLayout),
+		Fee:      fee,
+		Amount:   fromCents(toCents(dunningCase.Amount) + toCents(dunningCase.Fees) + toCents(fee)),
+	}
+	dunningCase.NextStage = ""
+	dunningCase.NextStageOn = ""
+	if index+1 < len(p.Stages) {
+		dunningCase.NextStage = p.Stages[index+1].Stage
+	}
+
+	dunningCase.Stage = level.Stage
+	dunningCase.Fees = fromCents(toCents(dunningCase.Fees) + toCents(fee))
+	dunningCase.Notices = append(dunningCase.Notices, notice)
+
+	return true
+}
+
+// paymentDeadline returns the deadline of the latest notice of the case if it is sent on the given day, or
+// an empty string for the last stage
+func (p DunningPolicy) paymentDeadline(dunningCase DunningCaseRes, sentOn time.Time) string {
+	index := len(dunningCase.Notices) - 1
+	if index < 0 || index+1 >= len(p.Stages) {
+		return ""
+	}
+
+	return sentOn.AddDate(0, 0, p.Stages[index].PaymentPeriod).Format(dateLayout)
+}
+
+// sent records that the latest notice of the case was sent on the given day. Its payment period only starts
+// then, so the customer gets every period in full even if the notice could not be sent right away, and the
+// cover is never suspended before the formal notice reached the customer (§38 Abs. 2 VVG).
+func (p DunningPolicy) sent(dunningCase *DunningCaseRes, sentOn time.Time) {
+	notice := &dunningCase.Notices[len(dunningCase.Notices)-1]
+	notice.SentOn = sentOn.Format(dateLayout)
+	notice.PaymentDeadline = p.paymentDeadline(*dunningCase, sentOn)
+	if notice.PaymentDeadline == "" {
+		return
+	}
+
+	deadline, _ := time.Parse(dateLayout, notice.PaymentDeadline)
+	dunningCase.NextStageOn = deadline.AddDate(0, 0, 1).Format(dateLayout)
+}
@@ -0,0 +1,291 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DunningScheduler opens a dunning case for every contract whose direct debits were returned and escalates it
+// stage by stage until the premiums are paid, sending the notice of every stage to the customer. Like the ContractScheduler, a run only depends on the stored
+// installments and cases and the current date, so repeating it on the same day changes nothing.
+type DunningScheduler struct {
+	repo     Repository
+	policy   DunningPolicy
+	mailer   Mailer
+	interval time.Duration
+	now      func() time.Time
+	// mu is the lock of the ContractAPIService, taken while suspending or reinstating a contract
+	mu *sync.Mutex
+}
+
+// DunningSchedulerOption for how the scheduler is set up.
+type DunningSchedulerOption func(*DunningScheduler)
+
+// WithDunningSchedulerClock injects the clock the scheduler derives the current date from
+func WithDunningSchedulerClock(now func() time.Time) DunningSchedulerOption {
+	return func(s *DunningScheduler) {
+		s.now = now
+	}
+}
+
+// WithDunningSchedulerInterval sets the time between two runs, one day by default
+func WithDunningSchedulerInterval(interval time.Duration) DunningSchedulerOption {
+	return func(s *DunningScheduler) {
+		s.interval = interval
+	}
+}
+
+// NewDunningScheduler creates a scheduler dunning the returned installments of repo according to policy and
+// sending the notices with mailer. contracts is the lock the ContractAPIService changes contracts under.
+func NewDunningScheduler(repo Repository, policy DunningPolicy, mailer Mailer, contracts *sync.Mutex, opts ...DunningSchedulerOption) *DunningScheduler {
+	scheduler := &DunningScheduler{
+		repo:     repo,
+		policy:   policy,
+		mailer:   mailer,
+		interval: 24 * time.Hour,
+		now:      time.Now,
+		mu:       contracts,
+	}
+
+	for _, opt := range opts {
+		opt(scheduler)
+	}
+
+	return scheduler
+}
+
+// Run processes the dunning cases right away and then once per interval until ctx is done
+func (s *DunningScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		escalated, settled, err := s.ProcessDue(ctx)
+		if err != nil {
+			log.Printf("Processing dunning cases failed: %v", err)
+		} else if escalated > 0 || settled > 0 {
+			log.Printf("Escalated %d and settled %d dunning cases", escalated, settled)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDue adds returned installments to the open case of their contract, opening one if there is none,
+// settles the cases whose installments are all paid and escalates the others whose next stage is due.
+// Notices that could not be sent are sent again by the next run; until then the case stays at its stage.
+func (s *DunningScheduler) ProcessDue(ctx context.Context) (escalated int, settled int, err error) {
+	installments, err := s.repo.ListInstallments(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	cases, err := s.repo.ListDunningCases(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	today, err := time.Parse(dateLayout, s.now().Format(dateLayout))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	open := make(map[string]*DunningCaseRes)
+	dunned := make(map[string]bool)
+	for i := range cases {
+		if cases[i].Status != DunningCaseStatusOpen {
+			continue
+		}
+		open[cases[i].ContractId] = &cases[i]
+		for _, installmentId := range cases[i].InstallmentIds {
+			dunned[installmentId] = true
+		}
+	}
+
+	opened := make(map[string]bool)
+	for _, installment := range installments {
+		if installment.Status != InstallmentStatusReturned || dunned[installment.Id] {
+			continue
+		}
+		dunningCase, ok := open[installment.ContractId]
+		if !ok {
+			contract, err := s.repo.GetContract(ctx, installment.ContractId)
+			if err != nil {
+				return escalated, settled, fmt.Errorf("contract %s: %w", installment.ContractId, err)
+			}
+			openedOn, err := time.Parse(dateLayout, installment.ReturnDate)
+			if err != nil {
+				openedOn = today
+			}
+			opening := s.policy.openDunningCase(contract, openedOn)
+			cases = append(cases, opening)
+			dunningCase = &opening
+			open[contract.Id] = dunningCase
+			opened[opening.Id] = true
+		} else if installment.ReturnDate != "" && installment.ReturnDate < dunningCase.OpenedOn && len(dunningCase.Notices) == 0 {
+			dunningCase.OpenedOn = installment.ReturnDate
+		}
+		dunningCase.InstallmentIds = append(dunningCase.InstallmentIds, installment.Id)
+	}
+
+	byId := make(map[string]InstallmentRes, len(installments))
+	for _, installment := range installments {
+		byId[installment.Id] = installment
+	}
+	for _, dunningCase := range open {
+		outstanding := int64(0)
+		for _, installmentId := range dunningCase.InstallmentIds {
+			if installment := byId[installmentId]; installment.Status != InstallmentStatusPaid {
+				outstanding += toCents(installment.Amount)
+			}
+		}
+		dunningCase.Amount = fromCents(outstanding)
+
+		if outstanding == 0 {
+			if err := s.settle(ctx, dunningCase, today); err != nil {
+				return escalated, settled, err
+			}
+			settled++
+		} else if s.policy.escalate(dunningCase, today) {
+			if dunningCase.Stage == DunningStageSuspension {
+				if err := s.suspendCover(ctx, dunningCase.ContractId); err != nil {
+					return escalated, settled, err
+				}
+			}
+			escalated++
+		}
+		if outstanding > 0 && len(dunningCase.Notices) > 0 && dunningCase.Notices[len(dunningCase.Notices)-1].SentOn == "" {
+			if err := s.sendNotice(ctx, dunningCase, today); err != nil {
+				log.Printf("Sending the %s of dunning case %s failed: %v", dunningCase.Stage, dunningCase.Id, err)
+			} else {
+				s.policy.sent(dunningCase, today)
+			}
+		}
+
+		if opened[dunningCase.Id] {
+			err = s.repo.CreateDunningCase(ctx, *dunningCase)
+		} else {
+			err = s.repo.UpdateDunningCase(ctx, *dunningCase)
+		}
+		if err != nil {
+			return escalated, settled, err
+		}
+	}
+
+	return escalated, settled, nil
+}
+
+// settle closes a case whose premiums are all paid. A cover suspended by the case is in force again from
+// the payment on (§38 Abs. 2 VVG), so the contract is reinstated.
+func (s *DunningScheduler) settle(ctx context.Context, dunningCase *DunningCaseRes, today time.Time) error {
+	dunningCase.Status = DunningCaseStatusSettled
+	dunningCase.SettledOn = today.Format(dateLayout)
+	dunningCase.NextStage = ""
+	dunningCase.NextStageOn = ""
+	if dunningCase.Stage != DunningStageSuspension {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	contract, err := s.repo.GetContract(ctx, dunningCase.ContractId)
+	if err != nil {
+		return fmt.Errorf("contract %s: %w", dunningCase.ContractId, err)
+	}
+	switch {
+	case contract.Status == ContractStatusSuspended:
+		if err := transitionContract(&contract, ContractStatusActive); err != nil {
+			return err
+		}
+	case contract.Status == ContractStatusCancelled && contract.StatusBeforeCancellation == ContractStatusSuspended:
+		// Reverting the cancellation must not restore the suspension
+		contract.StatusBeforeCancellation = ContractStatusActive
+	default:
+		return nil
+	}
+	contract.Modified = auditStamp(ctx, s.now())
+
+	return s.repo.UpdateContract(ctx, contract)
+}
+
+// suspendCover suspends the cover of an active contract once the deadline of the formal notice passed unpaid.
+// For a contract cancelled while active, the suspension becomes the status reinstating it restores. Other
+// contracts are left as they are.
+func (s *DunningScheduler) suspendCover(ctx context.Context, contractId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	contract, err := s.repo.GetContract(ctx, contractId)
+	if err != nil {
+		return fmt.Errorf("contract %s: %w", contractId, err)
+	}
+	switch {
+	case contract.Status == ContractStatusActive:
+		if err := transitionContract(&contract, ContractStatusSuspended); err != nil {
+			return err
+		}
+	case contract.Status == ContractStatusCancelled && contract.StatusBeforeCancellation == ContractStatusActive:
+		contract.StatusBeforeCancellation = ContractStatusSuspended
+	default:
+		return nil
+	}
+	contract.Modified = auditStamp(ctx, s.now())
+
+	return s.repo.UpdateContract(ctx, contract)
+}
+
+// noticeSubjects are the subjects of the notices of the dunning stages
+var noticeSubjects = map[DunningStage]string{
+	DunningStageReminder:       "Payment reminder",
+	DunningStageSecondReminder: "Second payment reminder",
+	DunningStageFormalNotice:   "Formal notice according to §38 VVG",
+	DunningStageSuspension:     "Suspension of your cover",
+}
+
+// sendNotice emails the latest notice of the case to the customer, with the payment deadline it gets if it
+// is sent today
+func (s *DunningScheduler) sendNotice(ctx context.Context, dunningCase *DunningCaseRes, today time.Time) error {
+	customer, err := s.repo.GetCustomer(ctx, dunningCase.CustomerId)
+	if err != nil {
+		return fmt.Errorf("customer %s: %w", dunningCase.CustomerId, err)
+	}
+
+	notice := dunningCase.Notices[len(dunningCase.Notices)-1]
+	var body strings.Builder
+	fmt.Fprintf(&body, "Dear %s %s,\n\nthe direct debit of your premiums for contract %s was returned. ", customer.FirstName, customer.LastName, dunningCase.ContractId)
+	fmt.Fprintf(&body, "%.2f € are outstanding", notice.Amount)
+	if notice.Fee > 0 {
+		fmt.Fprintf(&body, ", including a dunning fee of %.2f €", notice.Fee)
+	}
+	body.WriteString(".\n")
+	if deadline := s.policy.paymentDeadline(*dunningCase, today); deadline != "" {
+		fmt.Fprintf(&body, "\nPlease pay the amount by %s.\n", deadline)
+	}
+	switch notice.Stage {
+	case DunningStageFormalNotice:
+		body.WriteString("If it is not paid by then, you have no cover for insured events from the day after until it is paid (§38 Abs. 2 VVG).\n")
+	case DunningStageSuspension:
+		body.WriteString("\nAs the amount was not paid within the period of the formal notice, your cover is suspended. It is in force again from the day the amount is paid.\n")
+	}
+
+	return s.mailer.Send(ctx, Mail{
+		To:      customer.Email,
+		Subject: noticeSubjects[notice.Stage],
+		Body:    body.String(),
+	})
+}
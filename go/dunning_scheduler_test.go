@@ -0,0 +1,121 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testMailer records the mails it sends and fails while failing is set
+type testMailer struct {
+	failing bool
+	sent    []Mail
+}
+
+func (m *testMailer) Send(ctx context.Context, mail Mail) error {
+	if m.failing {
+		return errors.New("mail server unavailable")
+	}
+	m.sent = append(m.sent, mail)
+	return nil
+}
+
+func TestDunningSchedulerSendsNotices(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRepository(NewMemoryStore())
+	customer := CustomerRes{
+		Id:          "customer",
+		Email:       "max.mustermann@example.com",
+		FirstName:   "Max",
+		LastName:    "Mustermann",
+		Address:     Address{Id: "address"},
+		BankDetails: BankDetails{Id: "bankDetails"},
+	}
+	if err := repo.CreateCustomer(ctx, customer); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.CreateContract(ctx, ContractRes{Id: "contract", CustomerId: customer.Id, Status: ContractStatusActive}); err != nil {
+		t.Fatal(err)
+	}
+	err := repo.CreateInstallment(ctx, InstallmentRes{
+		Id:         "installment",
+		ContractId: "contract",
+		CustomerId: customer.Id,
+		Amount:     25,
+		Status:     InstallmentStatusReturned,
+		ReturnDate: "2026-03-02",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := DunningPolicy{Stages: []DunningLevel{
+		{Stage: DunningStageFormalNotice, Fee: 5, PaymentPeriod: 14},
+		{Stage: DunningStageSuspension},
+	}}
+	mailer := &testMailer{}
+	today := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	scheduler := NewDunningScheduler(repo, policy, mailer, &sync.Mutex{}, WithDunningSchedulerClock(func() time.Time { return today }))
+
+	steps := []struct {
+		name          string
+		day           string
+		failing       bool
+		wantSentOn    string
+		wantDeadline  string
+		wantMails     int
+		wantContract  ContractStatus
+		wantCaseStage DunningStage
+	}{
+		{name: "sending the formal notice fails", day: "2026-03-02", failing: true, wantMails: 0, wantContract: ContractStatusActive, wantCaseStage: DunningStageFormalNotice},
+		{name: "no suspension without a sent notice", day: "2026-03-20", failing: true, wantMails: 0, wantContract: ContractStatusActive, wantCaseStage: DunningStageFormalNotice},
+		{name: "notice sent later", day: "2026-03-21", wantSentOn: "2026-03-21", wantDeadline: "2026-04-04", wantMails: 1, wantContract: ContractStatusActive, wantCaseStage: DunningStageFormalNotice},
+		{name: "rerun sends nothing", day: "2026-03-22", wantSentOn: "2026-03-21", wantDeadline: "2026-04-04", wantMails: 1, wantContract: ContractStatusActive, wantCaseStage: DunningStageFormalNotice},
+		{name: "deadline counted from sending", day: "2026-04-04", wantSentOn: "2026-03-21", wantDeadline: "2026-04-04", wantMails: 1, wantContract: ContractStatusActive, wantCaseStage: DunningStageFormalNotice},
+		{name: "suspension after the deadline", day: "2026-04-05", wantSentOn: "2026-03-21", wantDeadline: "2026-04-04", wantMails: 2, wantContract: ContractStatusSuspended, wantCaseStage: DunningStageSuspension},
+	}
+	for _, step := range steps {
+		today, _ = time.Parse(dateLayout, step.day)
+		mailer.failing = step.failing
+		if _, _, err := scheduler.ProcessDue(ctx); err != nil {
+			t.Fatalf("%s: ProcessDue() error = %v", step.name, err)
+		}
+
+		cases, err := repo.ListDunningCases(ctx)
+		if err != nil || len(cases) != 1 {
+			t.Fatalf("%s: ListDunningCases() = %v, %v, want one case", step.name, cases, err)
+		}
+		notice := cases[0].Notices[0]
+		if notice.SentOn != step.wantSentOn || notice.PaymentDeadline != step.wantDeadline {
+			t.Errorf("%s: notice sent on %q with deadline %q, want %q and %q", step.name, notice.SentOn, notice.PaymentDeadline, step.wantSentOn, step.wantDeadline)
+		}
+		if cases[0].Stage != step.wantCaseStage {
+			t.Errorf("%s: case stage = %s, want %s", step.name, cases[0].Stage, step.wantCaseStage)
+		}
+		if len(mailer.sent) != step.wantMails {
+			t.Errorf("%s: %d mails sent, want %d", step.name, len(mailer.sent), step.wantMails)
+		}
+		contract, err := repo.GetContract(ctx, "contract")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if contract.Status != step.wantContract {
+			t.Errorf("%s: contract status = %s, want %s", step.name, contract.Status, step.wantContract)
+		}
+	}
+
+	if mailer.sent[0].To != customer.Email || mailer.sent[0].Subject != "Formal notice according to §38 VVG" {
+		t.Errorf("formal notice sent to %q with subject %q", mailer.sent[0].To, mailer.sent[0].Subject)
+	}
+}
@@ -10,9 +10,13 @@
 package openapi
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
 var (
@@ -22,7 +26,9 @@ var (
 
 // ParsingError indicates that an error has occurred when parsing request parameters
 type ParsingError struct {
-	Err error
+	// Param is the name of the parameter that could not be parsed, or empty for the request body
+	Param string
+	Err   error
 }
 
 func (e *ParsingError) Unwrap() error {
@@ -30,7 +36,11 @@ func (e *ParsingError) Unwrap() error {
 }
 
 func (e *ParsingError) Error() string {
-	return e.Err.Error()
+	if e.Param == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("parameter '%s': %v", e.Param, e.Err)
 }
 
 // RequiredError indicates that an error has occurred when parsing request parameters
@@ -42,21 +52,125 @@ func (e *RequiredError) Error() string {
 	return fmt.Sprintf("required field '%s' is zero value.", e.Field)
 }
 
+// Types of the problems reported for invalid requests. Other problems have the type about:blank,
+// their title is the description of the status code.
+const (
+	ProblemTypeValidation = "/problems/validation"
+	ProblemTypeMalformed  = "/problems/malformed-request"
+)
+
 // ErrorHandler defines the required method for handling error. You may implement it and inject this into a controller if
 // you would like errors to be handled differently from the DefaultErrorHandler
 type ErrorHandler func(w http.ResponseWriter, r *http.Request, err error, result *ImplResponse)
 
-// DefaultErrorHandler defines the default logic on how to handle errors from the controller. Any errors from parsing
-// request params will return a StatusBadRequest. Otherwise, the error code originating from the servicer will be used.
+// DefaultErrorHandler defines the default logic on how to handle errors from the controller. Errors are written as
+// application/problem+json as defined by RFC 7807. Missing and invalid values of the request are listed in the errors
+// of the problem with a StatusUnprocessableEntity if values are only missing, or a StatusBadRequest otherwise.
+// Otherwise, the error code originating from the servicer will be used.
 func DefaultErrorHandler(w http.ResponseWriter, r *http.Request, err error, result *ImplResponse) {
-	if _, ok := err.(*ParsingError); ok {
+	problem := Problem{Type: "about:blank", Instance: r.URL.Path, TraceId: TraceId(r.Context())}
+	if validationErr, ok := err.(*ValidationError); ok {
+		// Handle missing and invalid values, listing every one found
+		problem.Type = ProblemTypeValidation
+		problem.Title = "The request contains missing or invalid values"
+		problem.Status = http.StatusBadRequest
+		if validationErr.missingOnly() {
+			problem.Status = http.StatusUnprocessableEntity
+		}
+		problem.Errors = validationErr.Errors
+	} else if parsingErr, ok := err.(*ParsingError); ok {
 		// Handle parsing errors
-		EncodeJSONResponse(err.Error(), func(i int) *int { return &i }(http.StatusBadRequest), w)
-	} else if _, ok := err.(*RequiredError); ok {
+		problem.Type = ProblemTypeMalformed
+		problem.Title = "The request could not be parsed"
+		problem.Status = http.StatusBadRequest
+		problem.Detail = err.Error()
+		if problemErr, ok := parsingProblemError(parsingErr); ok {
+			problem.Errors = []ProblemError{problemErr}
+			if problemErr.Code == codeMinimum || problemErr.Code == codeMaximum {
+				// The parameter was parsed, but is out of bounds
+				problem.Type = ProblemTypeValidation
+				problem.Title = "The request contains missing or invalid values"
+				problem.Detail = ""
+			}
+		}
+	} else if requiredErr, ok := err.(*RequiredError); ok {
 		// Handle missing required errors
-		EncodeJSONResponse(err.Error(), func(i int) *int { return &i }(http.StatusUnprocessableEntity), w)
+		problem.Type = ProblemTypeValidation
+		problem.Title = "The request contains missing or invalid values"
+		problem.Status = http.StatusUnprocessableEntity
+		problem.Errors = []ProblemError{{Field: requiredErr.Field, Code: codeRequired, Message: "is required"}}
 	} else {
 		// Handle all other errors
-		EncodeJSONResponse(err.Error(), &result.Code, w)
+		problem.Status = http.StatusInternalServerError
+		if result != nil && result.Code != 0 {
+			problem.Status = int32(result.Code)
+		}
+		problem.Title = http.StatusText(int(problem.Status))
+		problem.Detail = err.Error()
+		if problem.Status >= http.StatusInternalServerError {
+			// Details of server errors are only logged, the trace id allows to find them
+			log.Printf("%s %s %s: %v", problem.TraceId, r.Method, r.URL.Path, err)
+			problem.Detail = ""
+		}
 	}
+
+	EncodeProblemResponse(problem, w)
+}
+
+// EncodeProblemResponse writes a problem to the http response as application/problem+json with the problem's status code
+func EncodeProblemResponse(problem Problem, w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json; charset=UTF-8")
+	w.WriteHeader(int(problem.Status))
+
+	return json.NewEncoder(w).Encode(problem)
+}
+
+// ProblemHandler returns a handler responding to every request with a problem of the given status code,
+// e.g. for requests to unknown paths
+func ProblemHandler(status int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		EncodeProblemResponse(Problem{
+			Type:     "about:blank",
+			Title:    http.StatusText(status),
+			Status:   int32(status),
+			Instance: r.URL.Path,
+			TraceId:  TraceId(r.Context()),
+		}, w)
+	})
+}
+
+// parsingProblemError describes which value of the request body could not be parsed, if the decoder tells
+func parsingProblemError(err *ParsingError) (ProblemError, bool) {
+	if err.Param != "" {
+		switch err.Err.Error() {
+		case errMsgMinValueConstraint:
+			return ProblemError{Field: err.Param, Code: codeMinimum, Message: "is below the minimum"}, true
+		case errMsgMaxValueConstraint:
+			return ProblemError{Field: err.Param, Code: codeMaximum, Message: "is above the maximum"}, true
+		}
+		return ProblemError{Field: err.Param, Code: codeType, Message: err.Err.Error()}, true
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err.Err, &typeErr) && typeErr.Field != "" {
+		return ProblemError{Field: "$." + typeErr.Field, Code: codeType, Message: fmt.Sprintf("must be of type %s", typeErr.Type)}, true
+	}
+	if field, ok := unknownJSONField(err.Err); ok {
+		return ProblemError{Field: "$." + field, Code: codeUnknown, Message: "is not a property of the request"}, true
+	}
+
+	return ProblemError{}, false
+}
+
+// unknownJSONField returns the property rejected by a json.Decoder with DisallowUnknownFields,
+// which reports it only in the error message
+func unknownJSONField(err error) (string, bool) {
+	const prefix = "json: unknown field "
+	message := err.Error()
+	if !strings.HasPrefix(message, prefix) {
+		return "", false
+	}
+	field, unquoteErr := strconv.Unquote(strings.TrimPrefix(message, prefix))
+
+	return field, unquoteErr == nil
 }
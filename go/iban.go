@@ -0,0 +1,89 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ibanLengths holds the length of the IBANs of every country of the SEPA scheme
+var ibanLengths = map[string]int{
+	"AD": 24, "AL": 28, "AT": 20, "BE": 16, "BG": 22, "CH": 21, "CY": 28, "CZ": 24, "DE": 22, "DK": 18,
+	"EE": 20, "ES": 24, "FI": 18, "FR": 27, "GB": 22, "GI": 23, "GR": 27, "HR": 21, "HU": 28, "IE": 22,
+	"IS": 26, "IT": 27, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "MC": 27, "MD": 24, "ME": 22, "MK": 19,
+	"MT": 31, "NL": 18, "NO": 15, "PL": 28, "PT": 25, "RO": 24, "SE": 24, "SI": 19, "SK": 24, "SM": 27,
+	"VA": 22,
+}
+
+// ibanBicCountries lists the countries of BICs that may be used with the IBANs of a country other than their own,
+// e.g. banks in Jersey have British IBANs
+var ibanBicCountries = map[string][]string{
+	"FI": {"AX"},
+	"FR": {"BL", "GF", "GP", "MF", "MQ", "PM", "RE", "YT"},
+	"GB": {"GG", "IM", "JE"},
+}
+
+// checkIban checks an IBAN as defined by ISO 13616: the country must take part in SEPA, the IBAN must have the
+// length used in that country and the IBAN, read as a number with its first four characters moved to the end
+// and letters replaced by 10 to 35, must be 1 modulo 97
+func checkIban(iban string) error {
+	country := iban[:2]
+	length, ok := ibanLengths[country]
+	if !ok {
+		return fmt.Errorf("must be the IBAN of a SEPA country, %s is none", country)
+	}
+	if len(iban) != length {
+		return fmt.Errorf("must have %d characters in %s", length, country)
+	}
+
+	remainder, ok := mod97(iban[4:] + iban[:4])
+	if !ok {
+		return errors.New("must consist of capital letters and digits")
+	}
+	if remainder != 1 {
+		return errors.New("has invalid check digits")
+	}
+
+	return nil
+}
+
+// mod97 returns the value of a string of capital letters and digits modulo 97, with letters replaced by 10 to 35
+// as defined by ISO 7064 MOD 97-10. It returns false if the string contains other characters.
+func mod97(value string) (int, bool) {
+	remainder := 0
+	for _, c := range value {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return 0, false
+		}
+	}
+
+	return remainder, true
+}
+
+// bicMatchesIban reports whether the country of the BIC is the country of the IBAN, or one of its territories
+func bicMatchesIban(bic string, iban string) bool {
+	bicCountry, ibanCountry := bic[4:6], iban[:2]
+	if bicCountry == ibanCountry {
+		return true
+	}
+	for _, country := range ibanBicCountries[ibanCountry] {
+		if bicCountry == country {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,65 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import "testing"
+
+func TestCheckIban(t *testing.T) {
+	tests := []struct {
+		name    string
+		iban    string
+		wantErr bool
+	}{
+		{name: "German", iban: "DE89370400440532013000"},
+		{name: "British with letters", iban: "GB82WEST12345698765432"},
+		{name: "Austrian", iban: "AT611904300234573201"},
+		{name: "French with letter in the account number", iban: "FR1420041010050500013M02606"},
+		{name: "Norwegian, the shortest", iban: "NO9386011117947"},
+		{name: "wrong check digits", iban: "DE88370400440532013000", wantErr: true},
+		{name: "mistyped account number", iban: "DE89370400440532013001", wantErr: true},
+		{name: "too short for its country", iban: "DE8937040044053201300", wantErr: true},
+		{name: "no SEPA country", iban: "US89370400440532013000", wantErr: true},
+		{name: "lower case", iban: "DE89370400440532o13000", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkIban(tt.iban); (err != nil) != tt.wantErr {
+				t.Errorf("checkIban(%q) error = %v, wantErr %v", tt.iban, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMod97(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   int
+		wantOk bool
+	}{
+		{value: "", want: 0, wantOk: true},
+		{value: "96", want: 96, wantOk: true},
+		{value: "97", want: 0, wantOk: true},
+		{value: "98", want: 1, wantOk: true},
+		{value: "A", want: 10, wantOk: true},
+		{value: "Z", want: 35, wantOk: true},
+		{value: "1A", want: 13, wantOk: true},
+		{value: "370400440532013000DE89", want: 1, wantOk: true},
+		{value: "a"},
+		{value: "12 34"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := mod97(tt.value)
+			if ok != tt.wantOk || (ok && got != tt.want) {
+				t.Errorf("mod97(%q) = %d, %v, want %d, %v", tt.value, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
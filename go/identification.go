@@ -0,0 +1,114 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// patternPensionInsuranceNumber matches the structure of a Rentenversicherungsnummer: area number,
+// birth date as DDMMYY, initial of the birth name, serial number and check digit
+var patternPensionInsuranceNumber = regexp.MustCompile(`^[0-9]{8}[A-Z][0-9]{3}$`)
+
+// checkTaxId checks a Steuerliche Identifikationsnummer. Of its first ten digits, which must not start
+// with 0, exactly one digit occurs two or three times, but not three times in a row. The eleventh digit is
+// the check digit computed by ISO 7064 MOD 11,10.
+func checkTaxId(taxId string) error {
+	if !patternElevenDigits.MatchString(taxId) {
+		return errors.New("must consist of 11 digits")
+	}
+	if taxId[0] == '0' {
+		return errors.New("must not start with 0")
+	}
+
+	counts := make(map[byte]int)
+	for i := 0; i < 10; i++ {
+		counts[taxId[i]]++
+	}
+	repeated := byte(0)
+	for digit, count := range counts {
+		if count > 3 || (count > 1 && repeated != 0) {
+			return errors.New("must repeat exactly one of the first 10 digits two or three times")
+		}
+		if count > 1 {
+			repeated = digit
+		}
+	}
+	if repeated == 0 {
+		return errors.New("must repeat exactly one of the first 10 digits two or three times")
+	}
+	for i := 2; i < 10; i++ {
+		if taxId[i] == repeated && taxId[i-1] == repeated && taxId[i-2] == repeated {
+			return errors.New("must not repeat a digit three times in a row")
+		}
+	}
+
+	if check := taxIdCheckDigit(taxId[:10]); taxId[10]-'0' != check {
+		return fmt.Errorf("has the check digit %c, expected %d", taxId[10], check)
+	}
+
+	return nil
+}
+
+// taxIdCheckDigit computes the ISO 7064 MOD 11,10 check digit of the digits
+func taxIdCheckDigit(digits string) byte {
+	product := 10
+	for i := 0; i < len(digits); i++ {
+		sum := (int(digits[i]-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = (sum * 2) % 11
+	}
+
+	check := 11 - product
+	if check == 10 {
+		check = 0
+	}
+
+	return byte(check)
+}
+
+// checkPensionInsuranceNumber checks a Rentenversicherungsnummer, e.g. 65170839J003. The date of birth it contains
+// must be a valid date and its last digit is the check digit over the other digits and the initial.
+func checkPensionInsuranceNumber(number string) error {
+	if !patternPensionInsuranceNumber.MatchString(number) {
+		return fmt.Errorf("must match the pattern %s", patternPensionInsuranceNumber)
+	}
+	if _, err := time.Parse("020106", number[2:8]); err != nil {
+		return errors.New("must contain a valid date of birth as DDMMYY after the area number")
+	}
+
+	if check := pensionInsuranceNumberCheckDigit(number[:11]); number[11]-'0' != check {
+		return fmt.Errorf("has the check digit %c, expected %d", number[11], check)
+	}
+
+	return nil
+}
+
+// pensionInsuranceNumberCheckDigit computes the check digit of the first 11 characters of a Rentenversicherungsnummer.
+// The initial is replaced by its two digit position in the alphabet, and the cross sums of the digits multiplied
+// by their weights are added up modulo 10.
+func pensionInsuranceNumberCheckDigit(number string) byte {
+	letter := number[8] - 'A' + 1
+	digits := number[:8] + fmt.Sprintf("%02d", letter) + number[9:11]
+	weights := []int{2, 1, 2, 5, 7, 1, 2, 1, 2, 1, 2, 1}
+
+	sum := 0
+	for i, weight := range weights {
+		product := int(digits[i]-'0') * weight
+		sum += product/10 + product%10
+	}
+
+	return byte(sum % 10)
+}
@@ -0,0 +1,63 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import "testing"
+
+func TestCheckTaxId(t *testing.T) {
+	tests := []struct {
+		name    string
+		taxId   string
+		wantErr bool
+	}{
+		{name: "digit repeated twice", taxId: "86095742719"},
+		{name: "digit repeated twice in a row", taxId: "23456789110"},
+		{name: "digit repeated three times", taxId: "65929970489"},
+		{name: "digit repeated three times apart", taxId: "12131456787"},
+		{name: "wrong check digit", taxId: "86095742718", wantErr: true},
+		{name: "leading zero", taxId: "01234567896", wantErr: true},
+		{name: "no digit repeated", taxId: "12345678903", wantErr: true},
+		{name: "two digits repeated", taxId: "12312345670", wantErr: true},
+		{name: "digit repeated three times in a row", taxId: "11123456786", wantErr: true},
+		{name: "digit repeated four times", taxId: "12111213456", wantErr: true},
+		{name: "too short", taxId: "8609574271", wantErr: true},
+		{name: "letter", taxId: "8609574271A", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkTaxId(tt.taxId); (err != nil) != tt.wantErr {
+				t.Errorf("checkTaxId(%q) error = %v, wantErr %v", tt.taxId, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckPensionInsuranceNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		number  string
+		wantErr bool
+	}{
+		{name: "valid", number: "65170839J003"},
+		{name: "valid with initial M", number: "12010180M013"},
+		{name: "valid with initial A", number: "50291299A126"},
+		{name: "wrong check digit", number: "65170839J004", wantErr: true},
+		{name: "invalid date of birth", number: "65310239J008", wantErr: true},
+		{name: "lower case initial", number: "65170839j003", wantErr: true},
+		{name: "too short", number: "6517083J003", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkPensionInsuranceNumber(tt.number); (err != nil) != tt.wantErr {
+				t.Errorf("checkPensionInsuranceNumber(%q) error = %v, wantErr %v", tt.number, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// jwtAlgorithm describes how the signature of a JSON Web Token is computed for one of the "alg" values of RFC 7518
+type jwtAlgorithm struct {
+	// kty is the JSON Web Key type the algorithm needs
+	kty  string
+	hash crypto.Hash
+	pss  bool
+}
+
+// jwtAlgorithms lists the accepted signature algorithms. Unsecured tokens with the algorithm "none" are rejected.
+var jwtAlgorithms = map[string]jwtAlgorithm{
+	"HS256": {kty: "oct", hash: crypto.SHA256},
+	"HS384": {kty: "oct", hash: crypto.SHA384},
+	"HS512": {kty: "oct", hash: crypto.SHA512},
+	"RS256": {kty: "RSA", hash: crypto.SHA256},
+	"RS384": {kty: "RSA", hash: crypto.SHA384},
+	"RS512": {kty: "RSA", hash: crypto.SHA512},
+	"PS256": {kty: "RSA", hash: crypto.SHA256, pss: true},
+	"PS384": {kty: "RSA", hash: crypto.SHA384, pss: true},
+	"PS512": {kty: "RSA", hash: crypto.SHA512, pss: true},
+	"ES256": {kty: "EC", hash: crypto.SHA256},
+	"ES384": {kty: "EC", hash: crypto.SHA384},
+	"ES512": {kty: "EC", hash: crypto.SHA512},
+}
+
+// JSONWebKey is a key tokens are verified with, in the representation of RFC 7517
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	// Alg restricts the key to a single algorithm if set
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	// K is the secret of a symmetric key
+	K string `json:"k,omitempty"`
+	// N and E are the modulus and exponent of an RSA public key
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Crv, X and Y are the curve and coordinates of an elliptic curve public key
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+
+	// key is the decoded secret or public key
+	key interface{}
+}
+
+// JSONWebKeySet is a set of keys as served by the JWKS endpoint of an identity provider
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// ParseJSONWebKeySet decodes a JSON Web Key Set. Keys that are not meant for signatures are skipped.
+func ParseJSONWebKeySet(data []byte) (JSONWebKeySet, error) {
+	set := JSONWebKeySet{}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return JSONWebKeySet{}, err
+	}
+
+	keys := make([]JSONWebKey, 0, len(set.Keys))
+	for i, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		if err := key.decode(); err != nil {
+			return JSONWebKeySet{}, fmt.Errorf("key %d %q: %w", i, key.Kid, err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return JSONWebKeySet{}, errors.New("the key set contains no signature key")
+	}
+	set.Keys = keys
+
+	return set, nil
+}
+
+// LoadJSONWebKeySet reads a JSON Web Key Set from a file
+func LoadJSONWebKeySet(path string) (JSONWebKeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return JSONWebKeySet{}, err
+	}
+	set, err := ParseJSONWebKeySet(data)
+	if err != nil {
+		return JSONWebKeySet{}, fmt.Errorf("key set %s: %w", path, err)
+	}
+
+	return set, nil
+}
+
+// NewSymmetricKey creates the key of HMAC signed tokens from a shared secret of at least 32 bytes
+func NewSymmetricKey(secret []byte) (JSONWebKey, error) {
+	key := JSONWebKey{Kty: "oct", K: base64.RawURLEncoding.EncodeToString(secret)}
+	if err := key.decode(); err != nil {
+		return JSONWebKey{}, err
+	}
+
+	return key, nil
+}
+
+// decode decodes the key material of the key's type
+func (k *JSONWebKey) decode() error {
+	if k.Alg != "" {
+		algorithm, ok := jwtAlgorithms[k.Alg]
+		if !ok || algorithm.kty != k.Kty {
+			return fmt.Errorf("unsupported algorithm %q for a key of type %q", k.Alg, k.Kty)
+		}
+	}
+
+	switch k.Kty {
+	case "oct":
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil || len(secret) < 32 {
+			return errors.New("a symmetric key needs a secret of at least 32 bytes")
+		}
+		k.key = secret
+	case "RSA":
+		n, errN := base64URLInt(k.N)
+		e, errE := base64URLInt(k.E)
+		if errN != nil || errE != nil || !e.IsInt64() || n.BitLen() < 2048 {
+			return errors.New("an RSA key needs a modulus of at least 2048 bits and an exponent")
+		}
+		k.key = &rsa.PublicKey{N: n, E: int(e.Int64())}
+	case "EC":
+		curve, ok := map[string]elliptic.Curve{"P-256": elliptic.P256(), "P-384": elliptic.P384(), "P-521": elliptic.P521()}[k.Crv]
+		if !ok {
+			return fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, errX := base64URLInt(k.X)
+		y, errY := base64URLInt(k.Y)
+		if errX != nil || errY != nil || !curve.IsOnCurve(x, y) {
+			return errors.New("the coordinates are not a point on the curve")
+		}
+		k.key = &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+	default:
+		return fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+
+	return nil
+}
+
+// verify checks the signature of the signing input of a token
+func (k JSONWebKey) verify(alg string, signingInput string, signature []byte) bool {
+	algorithm, ok := jwtAlgorithms[alg]
+	if !ok || algorithm.kty != k.Kty || (k.Alg != "" && k.Alg != alg) {
+		return false
+	}
+
+	switch key := k.key.(type) {
+	case []byte:
+		mac := hmac.New(algorithm.hash.New, key)
+		mac.Write([]byte(signingInput))
+		return hmac.Equal(mac.Sum(nil), signature)
+	case *rsa.PublicKey:
+		digest := algorithm.hash.New()
+		digest.Write([]byte(signingInput))
+		if algorithm.pss {
+			return rsa.VerifyPSS(key, algorithm.hash, digest.Sum(nil), signature, nil) == nil
+		}
+		return rsa.VerifyPKCS1v15(key, algorithm.hash, digest.Sum(nil), signature) == nil
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return false
+		}
+		digest := algorithm.hash.New()
+		digest.Write([]byte(signingInput))
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		return ecdsa.Verify(key, digest.Sum(nil), r, s)
+	default:
+		return false
+	}
+}
+
+// jwtHeader is the JOSE header of a token
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+}
+
+// jwtClaims are the registered claims of RFC 7519 and the claims describing the principal
+type jwtClaims struct {
+	Subject    string      `json:"sub"`
+	Issuer     string      `json:"iss,omitempty"`
+	Audience   jwtAudience `json:"aud,omitempty"`
+	ExpiresAt  float64     `json:"exp"`
+	NotBefore  float64     `json:"nbf,omitempty"`
+	IssuedAt   float64     `json:"iat,omitempty"`
+	Roles      []string    `json:"roles,omitempty"`
+	CustomerId string      `json:"customerId,omitempty"`
+	EmployeeId string      `json:"employeeId,omitempty"`
+}
+
+// jwtAudience is the audience claim, which is either a single string or an array of strings
+type jwtAudience []string
+
+// UnmarshalJSON implements json.Unmarshaler
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return errors.New("aud must be a string or an array of strings")
+	}
+	*a = multiple
+
+	return nil
+}
+
+// jwtLeeway is the clock skew tolerated when checking the expiry and not before claims
+const jwtLeeway = time.Minute
+
+// verifyJWT checks the signature of a token in compact serialization with the keys and returns its claims.
+// The token must expire; issuer and audience are checked if they are not empty.
+func verifyJWT(token string, keys []JSONWebKey, issuer string, audience string, now time.Time) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, errors.New("the token is not a signed JWT")
+	}
+
+	header := jwtHeader{}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return jwtClaims{}, fmt.Errorf("invalid token header: %w", err)
+	}
+	if _, ok := jwtAlgorithms[header.Alg]; !ok {
+		return jwtClaims{}, fmt.Errorf("the token algorithm %q is not supported", header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return jwtClaims{}, errors.New("invalid token signature")
+	}
+
+	verified := false
+	for _, key := range keys {
+		if header.Kid != "" && key.Kid != "" && key.Kid != header.Kid {
+			continue
+		}
+		if key.verify(header.Alg, parts[0]+"."+parts[1], signature) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return jwtClaims{}, errors.New("the token signature is invalid")
+	}
+
+	claims := jwtClaims{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return jwtClaims{}, fmt.Errorf("invalid token claims: %w", err)
+	}
+	switch {
+	case claims.Subject == "":
+		return jwtClaims{}, errors.New("the token has no subject")
+	case claims.ExpiresAt == 0:
+		return jwtClaims{}, errors.New("the token does not expire")
+	case now.Add(-jwtLeeway).After(jwtTime(claims.ExpiresAt)):
+		return jwtClaims{}, errors.New("the token has expired")
+	case claims.NotBefore != 0 && now.Add(jwtLeeway).Before(jwtTime(claims.NotBefore)):
+		return jwtClaims{}, errors.New("the token is not valid yet")
+	case issuer != "" && claims.Issuer != issuer:
+		return jwtClaims{}, fmt.Errorf("the token was not issued by %s", issuer)
+	case audience != "" && !contains(claims.Audience, audience):
+		return jwtClaims{}, fmt.Errorf("the token is not meant for %s", audience)
+	}
+
+	return claims, nil
+}
+
+// TokenIssuer signs the session tokens of users logging in with their password. The tokens are signed with
+// HS256 and a symmetric key, which the Authenticator must accept too.
+type TokenIssuer struct {
+	key      JSONWebKey
+	issuer   string
+	audience string
+	ttl      time.Duration
+}
+
+// NewTokenIssuer creates an issuer of tokens valid for ttl. Issuer and audience are only set if not empty.
+func NewTokenIssuer(key JSONWebKey, issuer string, audience string, ttl time.Duration) (*TokenIssuer, error) {
+	if _, ok := key.key.([]byte); !ok {
+		return nil, errors.New("session tokens can only be signed with a symmetric key")
+	}
+
+	return &TokenIssuer{key: key, issuer: issuer, audience: audience, ttl: ttl}, nil
+}
+
+// issue signs a token for the principal and returns it with the time it expires
+func (t *TokenIssuer) issue(principal Principal, now time.Time) (string, time.Time, error) {
+	expiresAt := now.Add(t.ttl).Truncate(time.Second)
+	// The time of issue has microseconds, so that tokens issued right after a password reset are told apart
+	// from those issued before, see Account.revoked
+	claims := jwtClaims{
+		Subject:    principal.Subject,
+		Issuer:     t.issuer,
+		ExpiresAt:  float64(expiresAt.Unix()),
+		IssuedAt:   float64(now.UnixMicro()) / 1e6,
+		Roles:      principal.Roles,
+		CustomerId: principal.CustomerId,
+		EmployeeId: principal.EmployeeId,
+	}
+	if t.audience != "" {
+		claims.Audience = jwtAudience{t.audience}
+	}
+
+	header, err := json.Marshal(jwtHeader{Alg: "HS256"})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(crypto.SHA256.New, t.key.key.([]byte))
+	mac.Write([]byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), expiresAt, nil
+}
+
+// decodeJWTPart decodes the base64url encoded JSON of a token's header or claims
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// jwtTime converts a NumericDate, the seconds since the epoch, to a time
+func jwtTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+// base64URLInt decodes a big-endian unsigned integer encoded as base64url
+func base64URLInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(data) == 0 {
+		return nil, errors.New("invalid integer")
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testJWT signs the header and claims, both given as JSON, with the HMAC secret and the hash of alg
+func testJWT(header string, claims string, secret string, hash crypto.Hash) string {
+	signingInput := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(hash.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyJWT(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	key, err := NewSymmetricKey([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key.Kid = "session"
+	restricted := key
+	restricted.Alg = "HS256"
+	now := time.Unix(1790000000, 0)
+
+	// claims valid at now, issued by and meant for the service
+	const claims = `{"sub":"max","iss":"cat-insurance","aud":["cat-insurance"],"exp":1790000600,"nbf":1789999400,"roles":["customer"]}`
+	const header = `{"alg":"HS256","kid":"session"}`
+	parts := strings.Split(testJWT(header, claims, secret, crypto.SHA256), ".")
+	tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(strings.Replace(claims, "customer", "employee", 1))) + "." + parts[2]
+
+	tests := []struct {
+		name    string
+		token   string
+		keys    []JSONWebKey
+		wantErr string
+	}{
+		{name: "valid token", token: testJWT(header, claims, secret, crypto.SHA256)},
+		{name: "valid token without kid", token: testJWT(`{"alg":"HS384"}`, claims, secret, crypto.SHA384)},
+		{name: "audience as a string", token: testJWT(header, strings.Replace(claims, `["cat-insurance"]`, `"cat-insurance"`, 1), secret, crypto.SHA256)},
+		{name: "expired within the leeway", token: testJWT(header, strings.Replace(claims, "1790000600", "1789999950", 1), secret, crypto.SHA256)},
+		{name: "not a JWT", token: "max", wantErr: "not a signed JWT"},
+		{name: "unsecured token", token: testJWT(`{"alg":"none"}`, claims, secret, crypto.SHA256), wantErr: `algorithm "none" is not supported`},
+		{name: "header no JSON", token: "bm8." + strings.SplitN(testJWT(header, claims, secret, crypto.SHA256), ".", 2)[1], wantErr: "invalid token header"},
+		{name: "signature no base64url", token: testJWT(header, claims, secret, crypto.SHA256) + "!", wantErr: "invalid token signature"},
+		{name: "wrong secret", token: testJWT(header, claims, "fedcba9876543210fedcba9876543210", crypto.SHA256), wantErr: "signature is invalid"},
+		{name: "RSA algorithm signed with the secret", token: testJWT(`{"alg":"RS256","kid":"session"}`, claims, secret, crypto.SHA256), wantErr: "signature is invalid"},
+		{name: "algorithm the key is restricted against", token: testJWT(`{"alg":"HS512","kid":"session"}`, claims, secret, crypto.SHA512), keys: []JSONWebKey{restricted}, wantErr: "signature is invalid"},
+		{name: "unknown kid", token: testJWT(`{"alg":"HS256","kid":"other"}`, claims, secret, crypto.SHA256), wantErr: "signature is invalid"},
+		{name: "claims changed after signing", token: tampered, wantErr: "signature is invalid"},
+		{name: "no subject", token: testJWT(header, strings.Replace(claims, `"sub":"max"`, `"sub":""`, 1), secret, crypto.SHA256), wantErr: "no subject"},
+		{name: "no expiry", token: testJWT(header, strings.Replace(claims, `"exp":1790000600`, `"iat":1789999400`, 1), secret, crypto.SHA256), wantErr: "does not expire"},
+		{name: "expired", token: testJWT(header, strings.Replace(claims, "1790000600", "1789999900", 1), secret, crypto.SHA256), wantErr: "has expired"},
+		{name: "not valid yet", token: testJWT(header, strings.Replace(claims, "1789999400", "1790000100", 1), secret, crypto.SHA256), wantErr: "not valid yet"},
+		{name: "other issuer", token: testJWT(header, strings.Replace(claims, `"iss":"cat-insurance"`, `"iss":"other"`, 1), secret, crypto.SHA256), wantErr: "not issued by cat-insurance"},
+		{name: "other audience", token: testJWT(header, strings.Replace(claims, `["cat-insurance"]`, `["other"]`, 1), secret, crypto.SHA256), wantErr: "not meant for cat-insurance"},
+		{name: "audience no string", token: testJWT(header, strings.Replace(claims, `["cat-insurance"]`, `42`, 1), secret, crypto.SHA256), wantErr: "invalid token claims"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := tt.keys
+			if keys == nil {
+				keys = []JSONWebKey{key}
+			}
+
+			verified, err := verifyJWT(tt.token, keys, "cat-insurance", "cat-insurance", now)
+			if (err != nil) != (tt.wantErr != "") || (err != nil && !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("verifyJWT() error = %v, want %q", err, tt.wantErr)
+			}
+			if err == nil && (verified.Subject != "max" || len(verified.Roles) != 1 || verified.Roles[0] != "customer") {
+				t.Errorf("verifyJWT() = %+v", verified)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Mail is a plain text email to a single recipient
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends the emails of the services, e.g. the tokens verifying the email address of an account
+type Mailer interface {
+	Send(context.Context, Mail) error
+}
+
+// LogMailer writes emails to the log instead of sending them. It is meant for development only, since the
+// log then contains the tokens sent by email.
+type LogMailer struct{}
+
+// Send writes the mail to the log
+func (LogMailer) Send(ctx context.Context, mail Mail) error {
+	log.Printf("%s mail to %s: %s\n%s", TraceId(ctx), mail.To, mail.Subject, mail.Body)
+	return nil
+}
+
+// SMTPMailer sends emails through an SMTP server, using STARTTLS if the server supports it
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer creates a mailer sending from the address from through the SMTP server at addr (host:port).
+// The mailer authenticates with PLAIN if a username is given, which net/smtp only allows over TLS or to localhost.
+func NewSMTPMailer(addr string, from string, username string, password string) (*SMTPMailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP server %q: %w", addr, err)
+	}
+
+	mailer := &SMTPMailer{addr: addr, from: from}
+	if username != "" {
+		mailer.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return mailer, nil
+}
+
+// Send sends the mail
+func (m *SMTPMailer) Send(ctx context.Context, mail Mail) error {
+	var message strings.Builder
+	fmt.Fprintf(&message, "From: %s\r\n", m.from)
+	fmt.Fprintf(&message, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mail.Subject)
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	message.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{mail.To}, []byte(message.String())); err != nil {
+		return fmt.Errorf("sending mail to %s: %w", mail.To, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// mandateExpiryMonths is the number of months after the last direct debit, or the signature if there was none,
+// after which a mandate expires as defined by the SEPA Core Direct Debit rulebook
+const mandateExpiryMonths = 36
+
+// patternCreditorId matches a Gläubiger-Identifikationsnummer: country, check digits, creditor business code and
+// national identifier
+var patternCreditorId = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{3}[A-Z0-9]{1,28}$`)
+
+// CheckCreditorId checks a SEPA creditor identifier such as DE98ZZZ09999999999. The check digits are computed
+// like those of an IBAN, over the national identifier only, skipping the creditor business code.
+func CheckCreditorId(creditorId string) error {
+	if !patternCreditorId.MatchString(creditorId) {
+		return fmt.Errorf("creditor id %q must match the pattern %s", creditorId, patternCreditorId)
+	}
+	if remainder, _ := mod97(creditorId[7:] + creditorId[:4]); remainder != 1 {
+		return fmt.Errorf("creditor id %s has invalid check digits", creditorId)
+	}
+
+	return nil
+}
+
+// newMandateReference returns a new unique mandate reference, e.g. CI-20261016-3F2A9C1B7D4E
+func newMandateReference(signatureDate time.Time) string {
+	return "CI-" + signatureDate.Format("20060102") + "-" + strings.ToUpper(strings.ReplaceAll(newId(), "-", "")[:12])
+}
+
+// withMandateExpiry marks an active mandate as expired if it was not used for mandateExpiryMonths on the given day
+func withMandateExpiry(mandate MandateRes, day time.Time) MandateRes {
+	if mandate.Status != MandateStatusActive {
+		return mandate
+	}
+
+	lastUse := mandate.SignatureDate
+	if mandate.LastCollectionDate != "" {
+		lastUse = mandate.LastCollectionDate
+	}
+	if used, err := time.Parse(dateLayout, lastUse); err == nil && !day.Before(used.AddDate(0, mandateExpiryMonths, 0)) {
+		mandate.Status = MandateStatusExpired
+	}
+
+	return mandate
+}
+
+// revokeMandate revokes an active mandate, or returns an error wrapping ErrInvalidTransition
+func revokeMandate(mandate *MandateRes, reason string, at time.Time) error {
+	if mandate.Status != MandateStatusActive {
+		return fmt.Errorf("%w: mandate %s is %s and cannot be revoked", ErrInvalidTransition, mandate.Reference, mandate.Status)
+	}
+	if strings.TrimSpace(reason) == "" {
+		return errors.New("a reason is required to revoke a mandate")
+	}
+
+	mandate.Status = MandateStatusRevoked
+	mandate.RevokedAt = at.UTC().Format(time.RFC3339)
+	mandate.RevocationReason = reason
+
+	return nil
+}
@@ -0,0 +1,35 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import "testing"
+
+func TestCheckCreditorId(t *testing.T) {
+	tests := []struct {
+		name       string
+		creditorId string
+		wantErr    bool
+	}{
+		{name: "valid", creditorId: "DE98ZZZ09999999999"},
+		{name: "business code is not checked", creditorId: "DE98ABC09999999999"},
+		{name: "wrong check digits", creditorId: "DE99ZZZ09999999999", wantErr: true},
+		{name: "mistyped national identifier", creditorId: "DE98ZZZ09999999998", wantErr: true},
+		{name: "lower case", creditorId: "de98ZZZ09999999999", wantErr: true},
+		{name: "no national identifier", creditorId: "DE98ZZZ", wantErr: true},
+		{name: "empty", creditorId: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckCreditorId(tt.creditorId); (err != nil) != tt.wantErr {
+				t.Errorf("CheckCreditorId(%q) error = %v, wantErr %v", tt.creditorId, err, tt.wantErr)
+			}
+		})
+	}
+}
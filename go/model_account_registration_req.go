@@ -0,0 +1,46 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type AccountRegistrationReq struct {
+
+	// Customer registering, as printed on the policy
+	CustomerId string `json:"customerId"`
+
+	// Email address stored for the customer
+	Email string `json:"email"`
+
+	Password string `json:"password"`
+}
+
+// AssertAccountRegistrationReqRequired checks if the required fields are not zero-ed
+func AssertAccountRegistrationReqRequired(obj AccountRegistrationReq) error {
+	elements := map[string]interface{}{
+		"customerId": obj.CustomerId,
+		"email": obj.Email,
+		"password": obj.Password,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertAccountRegistrationReqConstraints checks if the values respects the defined constraints
+func AssertAccountRegistrationReqConstraints(obj AccountRegistrationReq) error {
+	v := validator{}
+	v.uuid("customerId", obj.CustomerId)
+	v.email("email", obj.Email)
+	v.length("password", obj.Password, 12, 128)
+	return v.err()
+}
@@ -0,0 +1,54 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// AccountRes - User account a customer or employee logs in with
+type AccountRes struct {
+
+	Id string `json:"id"`
+
+	// Email address the account logs in with
+	Email string `json:"email"`
+
+	Status AccountStatus `json:"status"`
+
+	// Customer the account belongs to
+	CustomerId string `json:"customerId,omitempty"`
+
+	// Employee the account belongs to
+	EmployeeId string `json:"employeeId,omitempty"`
+
+	CreatedAt string `json:"createdAt"`
+
+	// Time the email address was verified
+	VerifiedAt string `json:"verifiedAt,omitempty"`
+}
+
+// AssertAccountResRequired checks if the required fields are not zero-ed
+func AssertAccountResRequired(obj AccountRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"email": obj.Email,
+		"status": obj.Status,
+		"createdAt": obj.CreatedAt,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertAccountResConstraints checks if the values respects the defined constraints
+func AssertAccountResConstraints(obj AccountRes) error {
+	return nil
+}
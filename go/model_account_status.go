@@ -0,0 +1,69 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// AccountStatus : State of a user account, pending until its email address is verified and disabled while its employee is inactive
+type AccountStatus string
+
+// List of AccountStatus
+const (
+	AccountStatusPending  AccountStatus = "pending"
+	AccountStatusActive   AccountStatus = "active"
+	AccountStatusDisabled AccountStatus = "disabled"
+)
+
+// AllowedAccountStatusEnumValues is all the allowed values of AccountStatus enum
+var AllowedAccountStatusEnumValues = []AccountStatus{
+	"pending",
+	"active",
+	"disabled",
+}
+
+// validAccountStatusEnumValue provides a map of AccountStatuss for fast verification of use input
+var validAccountStatusEnumValues = map[AccountStatus]struct{}{
+	"pending":  {},
+	"active":   {},
+	"disabled": {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v AccountStatus) IsValid() bool {
+	_, ok := validAccountStatusEnumValues[v]
+	return ok
+}
+
+// NewAccountStatusFromValue returns a pointer to a valid AccountStatus
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewAccountStatusFromValue(v string) (AccountStatus, error) {
+	ev := AccountStatus(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for AccountStatus: valid values are %v", v, AllowedAccountStatusEnumValues)
+}
+
+
+
+// AssertAccountStatusRequired checks if the required fields are not zero-ed
+func AssertAccountStatusRequired(obj AccountStatus) error {
+	return nil
+}
+
+// AssertAccountStatusConstraints checks if the values respects the defined constraints
+func AssertAccountStatusConstraints(obj AccountStatus) error {
+	return nil
+}
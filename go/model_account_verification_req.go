@@ -0,0 +1,35 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type AccountVerificationReq struct {
+
+	// Token sent to the email address of the account
+	Token string `json:"token"`
+}
+
+// AssertAccountVerificationReqRequired checks if the required fields are not zero-ed
+func AssertAccountVerificationReqRequired(obj AccountVerificationReq) error {
+	elements := map[string]interface{}{
+		"token": obj.Token,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertAccountVerificationReqConstraints checks if the values respects the defined constraints
+func AssertAccountVerificationReqConstraints(obj AccountVerificationReq) error {
+	return nil
+}
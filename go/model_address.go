@@ -10,10 +10,6 @@
 package openapi
 
 
-import (
-	"errors"
-)
-
 
 
 type Address struct {
@@ -38,22 +34,20 @@ func AssertAddressRequired(obj Address) error {
 		"city": obj.City,
 		"id": obj.Id,
 	}
-	for name, el := range elements {
-		if isZero := IsZeroValue(el); isZero {
-			return &RequiredError{Field: name}
-		}
-	}
+	v := validator{}
+	v.required(elements)
 
-	return nil
+	return v.err()
 }
 
 // AssertAddressConstraints checks if the values respects the defined constraints
 func AssertAddressConstraints(obj Address) error {
-	if obj.ZipCode < 0 {
-		return &ParsingError{Err: errors.New(errMsgMinValueConstraint)}
-	}
-	if obj.ZipCode > 99999 {
-		return &ParsingError{Err: errors.New(errMsgMaxValueConstraint)}
-	}
-	return nil
+	v := validator{}
+	v.pattern("street", obj.Street, patternCapitalized)
+	v.pattern("houseNumber", obj.HouseNumber, patternHouseNumber)
+	v.minimum("zipCode", float64(obj.ZipCode), 0)
+	v.maximum("zipCode", float64(obj.ZipCode), 99999)
+	v.pattern("city", obj.City, patternCapitalized)
+	v.uuid("id", obj.Id)
+	return v.err()
 }
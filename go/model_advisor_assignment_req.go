@@ -0,0 +1,37 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type AdvisorAssignmentReq struct {
+
+	// Employee becoming the advisor of the customers
+	EmployeeId string `json:"employeeId"`
+}
+
+// AssertAdvisorAssignmentReqRequired checks if the required fields are not zero-ed
+func AssertAdvisorAssignmentReqRequired(obj AdvisorAssignmentReq) error {
+	elements := map[string]interface{}{
+		"employeeId": obj.EmployeeId,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertAdvisorAssignmentReqConstraints checks if the values respects the defined constraints
+func AssertAdvisorAssignmentReqConstraints(obj AdvisorAssignmentReq) error {
+	v := validator{}
+	v.uuid("employeeId", obj.EmployeeId)
+	return v.err()
+}
@@ -0,0 +1,44 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// AuditStamp - Who changed a resource and when
+type AuditStamp struct {
+
+	At string `json:"at"`
+
+	// Caller that made the change: the account of a session, the subject of another token or the name of an API key; empty for changes made by the server itself, e.g. renewals
+	Subject string `json:"subject,omitempty"`
+
+	// Employee the caller acted as
+	EmployeeId string `json:"employeeId,omitempty"`
+
+	// Customer the caller acted as
+	CustomerId string `json:"customerId,omitempty"`
+}
+
+// AssertAuditStampRequired checks if the required fields are not zero-ed
+func AssertAuditStampRequired(obj AuditStamp) error {
+	elements := map[string]interface{}{
+		"at": obj.At,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertAuditStampConstraints checks if the values respects the defined constraints
+func AssertAuditStampConstraints(obj AuditStamp) error {
+	return nil
+}
@@ -14,33 +14,43 @@ package openapi
 
 type BankDetails struct {
 
+	// IBAN of a SEPA country with valid ISO 13616 check digits
 	Iban string `json:"iban"`
 
-	Bic string `json:"bic"`
+	// BIC of a bank in the country of the IBAN, may be omitted for German IBANs as it is derived from the bank code
+	Bic string `json:"bic,omitempty"`
 
+	// Name of the account holder
 	Name string `json:"name"`
 
 	Id string `json:"id"`
+
+	// Name of the bank, derived from the bank code of German IBANs
+	BankName string `json:"bankName,omitempty"`
 }
 
 // AssertBankDetailsRequired checks if the required fields are not zero-ed
 func AssertBankDetailsRequired(obj BankDetails) error {
 	elements := map[string]interface{}{
 		"iban": obj.Iban,
-		"bic": obj.Bic,
 		"name": obj.Name,
 		"id": obj.Id,
 	}
-	for name, el := range elements {
-		if isZero := IsZeroValue(el); isZero {
-			return &RequiredError{Field: name}
-		}
-	}
+	v := validator{}
+	v.required(elements)
 
-	return nil
+	return v.err()
 }
 
 // AssertBankDetailsConstraints checks if the values respects the defined constraints
 func AssertBankDetailsConstraints(obj BankDetails) error {
-	return nil
+	v := validator{}
+	v.identifier("iban", obj.Iban, patternIban, checkIban)
+	v.pattern("bic", obj.Bic, patternBic)
+	if patternIban.MatchString(obj.Iban) && patternBic.MatchString(obj.Bic) && !bicMatchesIban(obj.Bic, obj.Iban) {
+		v.fail("bic", codeInvalid, "must belong to a bank in %s, the country of the IBAN", obj.Iban[:2])
+	}
+	v.pattern("name", obj.Name, patternAccountName)
+	v.uuid("id", obj.Id)
+	return v.err()
 }
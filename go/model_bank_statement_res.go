@@ -0,0 +1,61 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// BankStatementRes - Imported camt.053 statement of the account premiums are collected to
+type BankStatementRes struct {
+
+	Id string `json:"id"`
+
+	// Id of the statement assigned by the bank
+	StatementId string `json:"statementId"`
+
+	Iban string `json:"iban"`
+
+	// Date and time the bank created the statement
+	CreatedAt string `json:"createdAt,omitempty"`
+
+	ImportedAt string `json:"importedAt"`
+
+	NumberOfEntries int32 `json:"numberOfEntries"`
+
+	// Entries matched to installments
+	Matched int32 `json:"matched"`
+
+	// Entries left for an employee to resolve
+	Unmatched int32 `json:"unmatched"`
+
+	Entries []StatementEntryRes `json:"entries,omitempty"`
+}
+
+// AssertBankStatementResRequired checks if the required fields are not zero-ed
+func AssertBankStatementResRequired(obj BankStatementRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"statementId": obj.StatementId,
+		"iban": obj.Iban,
+		"importedAt": obj.ImportedAt,
+		"numberOfEntries": obj.NumberOfEntries,
+		"matched": obj.Matched,
+		"unmatched": obj.Unmatched,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertBankStatementResConstraints checks if the values respects the defined constraints
+func AssertBankStatementResConstraints(obj BankStatementRes) error {
+	return nil
+}
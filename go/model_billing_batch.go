@@ -0,0 +1,53 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// BillingBatch - Payment information block of a pain.008 file, collecting the direct debits of one sequence type on one date
+type BillingBatch struct {
+
+	// PmtInfId of the block in the file
+	PaymentInformationId string `json:"paymentInformationId"`
+
+	CollectionDate string `json:"collectionDate"`
+
+	SequenceType MandateSequenceType `json:"sequenceType"`
+
+	NumberOfTransactions int32 `json:"numberOfTransactions"`
+
+	// Sum of the amounts in euros
+	ControlSum float32 `json:"controlSum"`
+
+	// Installments collected by the direct debits of the block
+	InstallmentIds []string `json:"installmentIds"`
+}
+
+// AssertBillingBatchRequired checks if the required fields are not zero-ed
+func AssertBillingBatchRequired(obj BillingBatch) error {
+	elements := map[string]interface{}{
+		"paymentInformationId": obj.PaymentInformationId,
+		"collectionDate": obj.CollectionDate,
+		"sequenceType": obj.SequenceType,
+		"numberOfTransactions": obj.NumberOfTransactions,
+		"controlSum": obj.ControlSum,
+		"installmentIds": obj.InstallmentIds,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertBillingBatchConstraints checks if the values respects the defined constraints
+func AssertBillingBatchConstraints(obj BillingBatch) error {
+	return nil
+}
@@ -0,0 +1,34 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type BillingRunReq struct {
+
+	// Collect the installments due until this date, by default those due until today
+	DueUntil string `json:"dueUntil,omitempty"`
+
+	// Only report what would be collected, without creating a file or changing installments and mandates
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// AssertBillingRunReqRequired checks if the required fields are not zero-ed
+func AssertBillingRunReqRequired(obj BillingRunReq) error {
+	return nil
+}
+
+// AssertBillingRunReqConstraints checks if the values respects the defined constraints
+func AssertBillingRunReqConstraints(obj BillingRunReq) error {
+	v := validator{}
+	v.date("dueUntil", obj.DueUntil)
+	return v.err()
+}
@@ -0,0 +1,63 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// BillingRunRes - Collection of the premiums due by SEPA direct debit
+type BillingRunRes struct {
+
+	Id string `json:"id"`
+
+	// MsgId of the pain.008 file
+	MessageId string `json:"messageId"`
+
+	CreatedAt string `json:"createdAt"`
+
+	DueUntil string `json:"dueUntil"`
+
+	DryRun bool `json:"dryRun,omitempty"`
+
+	NumberOfTransactions int32 `json:"numberOfTransactions"`
+
+	// Sum of the amounts in euros
+	ControlSum float32 `json:"controlSum"`
+
+	Batches []BillingBatch `json:"batches"`
+
+	// Installments due that were not collected, e.g. because their contract has no active mandate
+	Skipped []SkippedInstallment `json:"skipped,omitempty"`
+
+	// Name of the pain.008 file, empty for dry runs and runs without transactions
+	FileName string `json:"fileName,omitempty"`
+}
+
+// AssertBillingRunResRequired checks if the required fields are not zero-ed
+func AssertBillingRunResRequired(obj BillingRunRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"messageId": obj.MessageId,
+		"createdAt": obj.CreatedAt,
+		"dueUntil": obj.DueUntil,
+		"numberOfTransactions": obj.NumberOfTransactions,
+		"controlSum": obj.ControlSum,
+		"batches": obj.Batches,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertBillingRunResConstraints checks if the values respects the defined constraints
+func AssertBillingRunResConstraints(obj BillingRunRes) error {
+	return nil
+}
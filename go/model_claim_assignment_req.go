@@ -0,0 +1,37 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ClaimAssignmentReq struct {
+
+	// Adjuster taking over the review of the claim
+	EmployeeId string `json:"employeeId"`
+}
+
+// AssertClaimAssignmentReqRequired checks if the required fields are not zero-ed
+func AssertClaimAssignmentReqRequired(obj ClaimAssignmentReq) error {
+	elements := map[string]interface{}{
+		"employeeId": obj.EmployeeId,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertClaimAssignmentReqConstraints checks if the values respects the defined constraints
+func AssertClaimAssignmentReqConstraints(obj ClaimAssignmentReq) error {
+	v := validator{}
+	v.uuid("employeeId", obj.EmployeeId)
+	return v.err()
+}
@@ -0,0 +1,51 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ClaimDecisionReq struct {
+
+	// Adjuster the claim is assigned to
+	EmployeeId string `json:"employeeId"`
+
+	// approved, partiallyApproved or rejected
+	Decision ClaimStatus `json:"decision"`
+
+	// Amount to pay for a partially approved claim, below the settled payable amount
+	ApprovedAmount float32 `json:"approvedAmount,omitempty"`
+
+	Reason string `json:"reason"`
+}
+
+// AssertClaimDecisionReqRequired checks if the required fields are not zero-ed
+func AssertClaimDecisionReqRequired(obj ClaimDecisionReq) error {
+	elements := map[string]interface{}{
+		"employeeId": obj.EmployeeId,
+		"decision": obj.Decision,
+		"reason": obj.Reason,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertClaimDecisionReqConstraints checks if the values respects the defined constraints
+func AssertClaimDecisionReqConstraints(obj ClaimDecisionReq) error {
+	v := validator{}
+	v.uuid("employeeId", obj.EmployeeId)
+	if obj.Decision != "" && !obj.Decision.IsValid() {
+		v.fail("decision", codeEnum, "must be one of %q", AllowedClaimStatusEnumValues)
+	}
+	v.minimum("approvedAmount", float64(obj.ApprovedAmount), 0)
+	return v.err()
+}
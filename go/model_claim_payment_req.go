@@ -0,0 +1,40 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ClaimPaymentReq struct {
+
+	// Employee releasing the payment
+	EmployeeId string `json:"employeeId"`
+
+	// Reference of the bank transfer paying the claim
+	PaymentReference string `json:"paymentReference,omitempty"`
+}
+
+// AssertClaimPaymentReqRequired checks if the required fields are not zero-ed
+func AssertClaimPaymentReqRequired(obj ClaimPaymentReq) error {
+	elements := map[string]interface{}{
+		"employeeId": obj.EmployeeId,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertClaimPaymentReqConstraints checks if the values respects the defined constraints
+func AssertClaimPaymentReqConstraints(obj ClaimPaymentReq) error {
+	v := validator{}
+	v.uuid("employeeId", obj.EmployeeId)
+	return v.err()
+}
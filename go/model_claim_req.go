@@ -0,0 +1,48 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ClaimReq struct {
+
+	TreatmentDate string `json:"treatmentDate"`
+
+	Diagnosis string `json:"diagnosis"`
+
+	// Amount of the veterinary invoice in Euro
+	InvoiceAmount float32 `json:"invoiceAmount"`
+
+	VetPractice string `json:"vetPractice"`
+}
+
+// AssertClaimReqRequired checks if the required fields are not zero-ed
+func AssertClaimReqRequired(obj ClaimReq) error {
+	elements := map[string]interface{}{
+		"treatmentDate": obj.TreatmentDate,
+		"diagnosis": obj.Diagnosis,
+		"invoiceAmount": obj.InvoiceAmount,
+		"vetPractice": obj.VetPractice,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertClaimReqConstraints checks if the values respects the defined constraints
+func AssertClaimReqConstraints(obj ClaimReq) error {
+	v := validator{}
+	v.date("treatmentDate", obj.TreatmentDate)
+	v.minimum("invoiceAmount", float64(obj.InvoiceAmount), 0.01)
+	v.maximum("invoiceAmount", float64(obj.InvoiceAmount), 99999)
+	return v.err()
+}
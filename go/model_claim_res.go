@@ -0,0 +1,93 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ClaimRes struct {
+
+	Id string `json:"id"`
+
+	ContractId string `json:"contractId"`
+
+	CustomerId string `json:"customerId"`
+
+	TreatmentDate string `json:"treatmentDate"`
+
+	Diagnosis string `json:"diagnosis"`
+
+	// Amount of the veterinary invoice in Euro
+	InvoiceAmount float32 `json:"invoiceAmount"`
+
+	VetPractice string `json:"vetPractice"`
+
+	CreatedAt string `json:"createdAt"`
+
+	// First day of the contract year the treatment falls into
+	CoverageYearStart string `json:"coverageYearStart"`
+
+	// Deductible of the contract's tariff subtracted from the invoice amount
+	Deductible float32 `json:"deductible"`
+
+	// Share of the invoice amount after the deductible paid by the customer
+	CoPayment float32 `json:"coPayment"`
+
+	// Amount paid out to the customer
+	PayableAmount float32 `json:"payableAmount"`
+
+	// Coverage left in the contract year after this claim
+	RemainingCoverage float32 `json:"remainingCoverage"`
+
+	Status ClaimStatus `json:"status"`
+
+	// Why the claim was held back for review by an adjuster
+	ReviewReasons []string `json:"reviewReasons,omitempty"`
+
+	// Adjuster reviewing the claim
+	AdjusterId string `json:"adjusterId,omitempty"`
+
+	// Reason given with the adjuster's decision
+	DecisionReason string `json:"decisionReason,omitempty"`
+
+	PaidAt string `json:"paidAt,omitempty"`
+
+	// Reference of the bank transfer paying the claim
+	PaymentReference string `json:"paymentReference,omitempty"`
+
+	// Status transitions of the claim, oldest first
+	History []ClaimTransition `json:"history,omitempty"`
+}
+
+// AssertClaimResRequired checks if the required fields are not zero-ed
+func AssertClaimResRequired(obj ClaimRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"contractId": obj.ContractId,
+		"customerId": obj.CustomerId,
+		"treatmentDate": obj.TreatmentDate,
+		"diagnosis": obj.Diagnosis,
+		"invoiceAmount": obj.InvoiceAmount,
+		"vetPractice": obj.VetPractice,
+		"createdAt": obj.CreatedAt,
+		"coverageYearStart": obj.CoverageYearStart,
+		"status": obj.Status,
+	}
+	// deductible, coPayment, payableAmount and remainingCoverage are not checked, 0 is a valid value
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertClaimResConstraints checks if the values respects the defined constraints
+func AssertClaimResConstraints(obj ClaimRes) error {
+	return nil
+}
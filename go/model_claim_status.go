@@ -0,0 +1,78 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// ClaimStatus : Workflow state of a claim
+type ClaimStatus string
+
+// List of ClaimStatus
+const (
+	ClaimStatusSubmitted         ClaimStatus = "submitted"
+	ClaimStatusInReview          ClaimStatus = "inReview"
+	ClaimStatusApproved          ClaimStatus = "approved"
+	ClaimStatusPartiallyApproved ClaimStatus = "partiallyApproved"
+	ClaimStatusRejected          ClaimStatus = "rejected"
+	ClaimStatusPaid              ClaimStatus = "paid"
+)
+
+// AllowedClaimStatusEnumValues is all the allowed values of ClaimStatus enum
+var AllowedClaimStatusEnumValues = []ClaimStatus{
+	"submitted",
+	"inReview",
+	"approved",
+	"partiallyApproved",
+	"rejected",
+	"paid",
+}
+
+// validClaimStatusEnumValue provides a map of ClaimStatuss for fast verification of use input
+var validClaimStatusEnumValues = map[ClaimStatus]struct{}{
+	"submitted":         {},
+	"inReview":          {},
+	"approved":          {},
+	"partiallyApproved": {},
+	"rejected":          {},
+	"paid":              {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v ClaimStatus) IsValid() bool {
+	_, ok := validClaimStatusEnumValues[v]
+	return ok
+}
+
+// NewClaimStatusFromValue returns a pointer to a valid ClaimStatus
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewClaimStatusFromValue(v string) (ClaimStatus, error) {
+	ev := ClaimStatus(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for ClaimStatus: valid values are %v", v, AllowedClaimStatusEnumValues)
+}
+
+
+
+// AssertClaimStatusRequired checks if the required fields are not zero-ed
+func AssertClaimStatusRequired(obj ClaimStatus) error {
+	return nil
+}
+
+// AssertClaimStatusConstraints checks if the values respects the defined constraints
+func AssertClaimStatusConstraints(obj ClaimStatus) error {
+	return nil
+}
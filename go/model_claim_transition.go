@@ -0,0 +1,45 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ClaimTransition struct {
+
+	At string `json:"at"`
+
+	// Status before the transition, empty for the submission
+	From ClaimStatus `json:"from,omitempty"`
+
+	To ClaimStatus `json:"to"`
+
+	// Adjuster who made the transition, empty for automatic transitions
+	EmployeeId string `json:"employeeId,omitempty"`
+
+	Reason string `json:"reason,omitempty"`
+}
+
+// AssertClaimTransitionRequired checks if the required fields are not zero-ed
+func AssertClaimTransitionRequired(obj ClaimTransition) error {
+	elements := map[string]interface{}{
+		"at": obj.At,
+		"to": obj.To,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertClaimTransitionConstraints checks if the values respects the defined constraints
+func AssertClaimTransitionConstraints(obj ClaimTransition) error {
+	return nil
+}
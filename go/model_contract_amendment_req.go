@@ -0,0 +1,61 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ContractAmendmentReq struct {
+
+	// Date from which on the amended values apply
+	EffectiveDate string `json:"effectiveDate"`
+
+	Coverage *float32 `json:"coverage,omitempty"`
+
+	Neutered *bool `json:"neutered,omitempty"`
+
+	// In Gramm
+	Weight *float32 `json:"weight,omitempty"`
+
+	Environment *string `json:"environment,omitempty"`
+
+	// New address of the customer
+	Address *Address `json:"address,omitempty"`
+}
+
+// AssertContractAmendmentReqRequired checks if the required fields are not zero-ed
+func AssertContractAmendmentReqRequired(obj ContractAmendmentReq) error {
+	elements := map[string]interface{}{
+		"effectiveDate": obj.EffectiveDate,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertContractAmendmentReqConstraints checks if the values respects the defined constraints
+func AssertContractAmendmentReqConstraints(obj ContractAmendmentReq) error {
+	v := validator{}
+	v.date("effectiveDate", obj.EffectiveDate)
+	if obj.Coverage != nil {
+		v.minimum("coverage", float64(*obj.Coverage), 1)
+	}
+	if obj.Weight != nil {
+		v.minimum("weight", float64(*obj.Weight), 50)
+	}
+	if obj.Environment != nil {
+		v.pattern("environment", *obj.Environment, patternCapitalized)
+	}
+	if obj.Address != nil {
+		v.nested("address", AssertAddressConstraints(*obj.Address))
+	}
+	return v.err()
+}
@@ -0,0 +1,39 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ContractCancellationReq struct {
+
+	Reason string `json:"reason"`
+
+	// Date the cancellation takes effect. Defaults to the earliest date the notice period allows.
+	EffectiveDate string `json:"effectiveDate,omitempty"`
+}
+
+// AssertContractCancellationReqRequired checks if the required fields are not zero-ed
+func AssertContractCancellationReqRequired(obj ContractCancellationReq) error {
+	elements := map[string]interface{}{
+		"reason": obj.Reason,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertContractCancellationReqConstraints checks if the values respects the defined constraints
+func AssertContractCancellationReqConstraints(obj ContractCancellationReq) error {
+	v := validator{}
+	v.date("effectiveDate", obj.EffectiveDate)
+	return v.err()
+}
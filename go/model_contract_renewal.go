@@ -0,0 +1,49 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ContractRenewal struct {
+
+	RenewedAt string `json:"renewedAt"`
+
+	PreviousEndDate string `json:"previousEndDate"`
+
+	EndDate string `json:"endDate"`
+
+	PreviousRate float32 `json:"previousRate"`
+
+	Rate float32 `json:"rate"`
+
+	TariffVersion string `json:"tariffVersion"`
+}
+
+// AssertContractRenewalRequired checks if the required fields are not zero-ed
+func AssertContractRenewalRequired(obj ContractRenewal) error {
+	elements := map[string]interface{}{
+		"renewedAt": obj.RenewedAt,
+		"previousEndDate": obj.PreviousEndDate,
+		"endDate": obj.EndDate,
+		"previousRate": obj.PreviousRate,
+		"rate": obj.Rate,
+		"tariffVersion": obj.TariffVersion,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertContractRenewalConstraints checks if the values respects the defined constraints
+func AssertContractRenewalConstraints(obj ContractRenewal) error {
+	return nil
+}
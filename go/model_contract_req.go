@@ -10,16 +10,13 @@
 package openapi
 
 
-import (
-	"errors"
-)
-
 
 
 type ContractReq struct {
 
 	StartDate string `json:"startDate"`
 
+	// Must lie after startDate
 	EndDate string `json:"endDate"`
 
 	Coverage float32 `json:"coverage"`
@@ -42,6 +39,14 @@ type ContractReq struct {
 	Weight float32 `json:"weight"`
 
 	CustomerId string `json:"customerId"`
+
+	// Id of a saved quote whose rate is locked in for the contract
+	QuoteId string `json:"quoteId,omitempty"`
+
+	// Renew the contract automatically when it ends
+	AutoRenew bool `json:"autoRenew,omitempty"`
+
+	PaymentFrequency PaymentFrequency `json:"paymentFrequency,omitempty"`
 }
 
 // AssertContractReqRequired checks if the required fields are not zero-ed
@@ -54,28 +59,36 @@ func AssertContractReqRequired(obj ContractReq) error {
 		"breed": obj.Breed,
 		"color": obj.Color,
 		"birthDate": obj.BirthDate,
-		"neutered": obj.Neutered,
 		"personality": obj.Personality,
 		"environment": obj.Environment,
 		"weight": obj.Weight,
 		"customerId": obj.CustomerId,
 	}
-	for name, el := range elements {
-		if isZero := IsZeroValue(el); isZero {
-			return &RequiredError{Field: name}
-		}
-	}
+	// neutered is not checked, false is a valid value for a required boolean
+	v := validator{}
+	v.required(elements)
 
-	return nil
+	return v.err()
 }
 
 // AssertContractReqConstraints checks if the values respects the defined constraints
 func AssertContractReqConstraints(obj ContractReq) error {
-	if obj.Coverage < 1 {
-		return &ParsingError{Err: errors.New(errMsgMinValueConstraint)}
-	}
-	if obj.Weight < 50 {
-		return &ParsingError{Err: errors.New(errMsgMinValueConstraint)}
+	v := validator{}
+	v.date("startDate", obj.StartDate)
+	v.date("endDate", obj.EndDate)
+	v.dateAfter("endDate", obj.EndDate, "startDate", obj.StartDate)
+	v.minimum("coverage", float64(obj.Coverage), 1)
+	v.pattern("catName", obj.CatName, patternCapitalized)
+	v.pattern("breed", obj.Breed, patternCapitalized)
+	v.pattern("color", obj.Color, patternCapitalized)
+	v.date("birthDate", obj.BirthDate)
+	v.pattern("personality", obj.Personality, patternCapitalized)
+	v.pattern("environment", obj.Environment, patternCapitalized)
+	v.minimum("weight", float64(obj.Weight), 50)
+	v.uuid("customerId", obj.CustomerId)
+	v.uuid("quoteId", obj.QuoteId)
+	if obj.PaymentFrequency != "" && !obj.PaymentFrequency.IsValid() {
+		v.fail("paymentFrequency", codeEnum, "must be one of %q", AllowedPaymentFrequencyEnumValues)
 	}
-	return nil
+	return v.err()
 }
@@ -44,6 +44,46 @@ type ContractRes struct {
 	Weight float32 `json:"weight"`
 
 	CustomerId string `json:"customerId"`
+
+	// Id of a saved quote whose rate is locked in for the contract
+	QuoteId string `json:"quoteId,omitempty"`
+
+	// Renew the contract automatically when it ends
+	AutoRenew bool `json:"autoRenew,omitempty"`
+
+	Status ContractStatus `json:"status"`
+
+	CancellationReason string `json:"cancellationReason,omitempty"`
+
+	// Date the cancellation takes effect
+	CancellationDate string `json:"cancellationDate,omitempty"`
+
+	// Status of a cancelled contract before its cancellation, which reinstating the contract restores
+	StatusBeforeCancellation ContractStatus `json:"statusBeforeCancellation,omitempty"`
+
+	// Automatic renewals of the contract, oldest first
+	Renewals []ContractRenewal `json:"renewals,omitempty"`
+
+	// Yearly premium locked in when the contract was created
+	Rate float32 `json:"rate,omitempty"`
+
+	// Version of the tariff the rate was calculated with
+	TariffVersion string `json:"tariffVersion,omitempty"`
+
+	// Current version of the contract, incremented by every amendment
+	Version int32 `json:"version,omitempty"`
+
+	PaymentFrequency PaymentFrequency `json:"paymentFrequency,omitempty"`
+
+	// Premium due per installment, including the surcharge for paying in installments
+	InstallmentAmount float32 `json:"installmentAmount,omitempty"`
+
+	// Part of the installment amount that is surcharge for paying in installments
+	InstallmentSurcharge float32 `json:"installmentSurcharge,omitempty"`
+
+	Created *AuditStamp `json:"created,omitempty"`
+
+	Modified *AuditStamp `json:"modified,omitempty"`
 }
 
 // AssertContractResRequired checks if the required fields are not zero-ed
@@ -57,19 +97,17 @@ func AssertContractResRequired(obj ContractRes) error {
 		"breed": obj.Breed,
 		"color": obj.Color,
 		"birthDate": obj.BirthDate,
-		"neutered": obj.Neutered,
 		"personality": obj.Personality,
 		"environment": obj.Environment,
 		"weight": obj.Weight,
 		"customerId": obj.CustomerId,
+		"status": obj.Status,
 	}
-	for name, el := range elements {
-		if isZero := IsZeroValue(el); isZero {
-			return &RequiredError{Field: name}
-		}
-	}
+	// neutered is not checked, false is a valid value for a required boolean
+	v := validator{}
+	v.required(elements)
 
-	return nil
+	return v.err()
 }
 
 // AssertContractResConstraints checks if the values respects the defined constraints
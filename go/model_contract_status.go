@@ -0,0 +1,75 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// ContractStatus : Lifecycle state of a contract
+type ContractStatus string
+
+// List of ContractStatus
+const (
+	ContractStatusDraft     ContractStatus = "draft"
+	ContractStatusActive    ContractStatus = "active"
+	ContractStatusSuspended ContractStatus = "suspended"
+	ContractStatusCancelled ContractStatus = "cancelled"
+	ContractStatusExpired   ContractStatus = "expired"
+)
+
+// AllowedContractStatusEnumValues is all the allowed values of ContractStatus enum
+var AllowedContractStatusEnumValues = []ContractStatus{
+	"draft",
+	"active",
+	"suspended",
+	"cancelled",
+	"expired",
+}
+
+// validContractStatusEnumValue provides a map of ContractStatuss for fast verification of use input
+var validContractStatusEnumValues = map[ContractStatus]struct{}{
+	"draft":     {},
+	"active":    {},
+	"suspended": {},
+	"cancelled": {},
+	"expired":   {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v ContractStatus) IsValid() bool {
+	_, ok := validContractStatusEnumValues[v]
+	return ok
+}
+
+// NewContractStatusFromValue returns a pointer to a valid ContractStatus
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewContractStatusFromValue(v string) (ContractStatus, error) {
+	ev := ContractStatus(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for ContractStatus: valid values are %v", v, AllowedContractStatusEnumValues)
+}
+
+
+
+// AssertContractStatusRequired checks if the required fields are not zero-ed
+func AssertContractStatusRequired(obj ContractStatus) error {
+	return nil
+}
+
+// AssertContractStatusConstraints checks if the values respects the defined constraints
+func AssertContractStatusConstraints(obj ContractStatus) error {
+	return nil
+}
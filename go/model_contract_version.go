@@ -0,0 +1,73 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type ContractVersion struct {
+
+	ContractId string `json:"contractId"`
+
+	// Version number, starting with 1 for the contract as created
+	Version int32 `json:"version"`
+
+	EffectiveDate string `json:"effectiveDate"`
+
+	CreatedAt string `json:"createdAt"`
+
+	Coverage float32 `json:"coverage"`
+
+	Neutered bool `json:"neutered"`
+
+	// In Gramm
+	Weight float32 `json:"weight"`
+
+	Environment string `json:"environment"`
+
+	ZipCode float32 `json:"zipCode"`
+
+	// Yearly premium from the effective date on
+	Rate float32 `json:"rate"`
+
+	TariffVersion string `json:"tariffVersion"`
+
+	// Pro rata premium difference for the rest of the current term, negative for a refund
+	PremiumAdjustment float32 `json:"premiumAdjustment"`
+
+	// Whether the version starts a new term of an automatic renewal instead of amending the contract
+	Renewal bool `json:"renewal,omitempty"`
+}
+
+// AssertContractVersionRequired checks if the required fields are not zero-ed
+func AssertContractVersionRequired(obj ContractVersion) error {
+	elements := map[string]interface{}{
+		"contractId": obj.ContractId,
+		"version": obj.Version,
+		"effectiveDate": obj.EffectiveDate,
+		"createdAt": obj.CreatedAt,
+		"coverage": obj.Coverage,
+		"weight": obj.Weight,
+		"environment": obj.Environment,
+		"zipCode": obj.ZipCode,
+		"rate": obj.Rate,
+		"tariffVersion": obj.TariffVersion,
+	}
+	// neutered and premiumAdjustment are not checked, false and 0 are valid values
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertContractVersionConstraints checks if the values respects the defined constraints
+func AssertContractVersionConstraints(obj ContractVersion) error {
+	return nil
+}
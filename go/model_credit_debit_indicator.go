@@ -0,0 +1,66 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// CreditDebitIndicator : Whether a bank statement entry credits (CRDT) or debits (DBIT) the account
+type CreditDebitIndicator string
+
+// List of CreditDebitIndicator
+const (
+	CreditDebitIndicatorCrdt CreditDebitIndicator = "CRDT"
+	CreditDebitIndicatorDbit CreditDebitIndicator = "DBIT"
+)
+
+// AllowedCreditDebitIndicatorEnumValues is all the allowed values of CreditDebitIndicator enum
+var AllowedCreditDebitIndicatorEnumValues = []CreditDebitIndicator{
+	"CRDT",
+	"DBIT",
+}
+
+// validCreditDebitIndicatorEnumValue provides a map of CreditDebitIndicators for fast verification of use input
+var validCreditDebitIndicatorEnumValues = map[CreditDebitIndicator]struct{}{
+	"CRDT": {},
+	"DBIT": {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v CreditDebitIndicator) IsValid() bool {
+	_, ok := validCreditDebitIndicatorEnumValues[v]
+	return ok
+}
+
+// NewCreditDebitIndicatorFromValue returns a pointer to a valid CreditDebitIndicator
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewCreditDebitIndicatorFromValue(v string) (CreditDebitIndicator, error) {
+	ev := CreditDebitIndicator(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for CreditDebitIndicator: valid values are %v", v, AllowedCreditDebitIndicatorEnumValues)
+}
+
+
+
+// AssertCreditDebitIndicatorRequired checks if the required fields are not zero-ed
+func AssertCreditDebitIndicatorRequired(obj CreditDebitIndicator) error {
+	return nil
+}
+
+// AssertCreditDebitIndicatorConstraints checks if the values respects the defined constraints
+func AssertCreditDebitIndicatorConstraints(obj CreditDebitIndicator) error {
+	return nil
+}
@@ -26,8 +26,10 @@ type CustomerReq struct {
 
 	BirthDate string `json:"birthDate"`
 
+	// Rentenversicherungsnummer: area number, date of birth as DDMMYY, initial of the birth name, serial number and check digit
 	SocialSecurityNumber string `json:"socialSecurityNumber"`
 
+	// Steuerliche Identifikationsnummer with a valid check digit, unique among all customers
 	TaxId string `json:"taxId"`
 
 	JobStatus string `json:"jobStatus"`
@@ -51,22 +53,34 @@ func AssertCustomerReqRequired(obj CustomerReq) error {
 		"address": obj.Address,
 		"bankDetails": obj.BankDetails,
 	}
-	for name, el := range elements {
-		if isZero := IsZeroValue(el); isZero {
-			return &RequiredError{Field: name}
-		}
-	}
+	v := validator{}
+	v.required(elements)
 
-	if err := AssertAddressRequired(obj.Address); err != nil {
-		return err
-	}
-	if err := AssertBankDetailsRequired(obj.BankDetails); err != nil {
-		return err
-	}
-	return nil
+	v.nested("address", AssertAddressRequired(obj.Address))
+	v.nested("bankDetails", AssertBankDetailsRequired(obj.BankDetails))
+	return v.err()
 }
 
+// Allowed values of the enums of CustomerReq
+var (
+	customerTitles         = []string{"Dr.", "Prof. Dr.", "Dr. Dr.", "Prof. Dr. Dr"}
+	customerFamilyStatuses = []string{"ledig", "verheiratet", "geschieden", "verwitwet"}
+	customerJobStatuses    = []string{"arbeitslos", "Schueler", "Student", "Vollzeit", "Teilzeit", "Minijob", "Werkstudent"}
+)
+
 // AssertCustomerReqConstraints checks if the values respects the defined constraints
 func AssertCustomerReqConstraints(obj CustomerReq) error {
-	return nil
+	v := validator{}
+	v.email("email", obj.Email)
+	v.pattern("firstName", obj.FirstName, patternCapitalized)
+	v.pattern("lastName", obj.LastName, patternCapitalized)
+	v.enum("title", obj.Title, customerTitles)
+	v.enum("familyStatus", obj.FamilyStatus, customerFamilyStatuses)
+	v.date("birthDate", obj.BirthDate)
+	v.identifier("socialSecurityNumber", obj.SocialSecurityNumber, patternPensionInsuranceNumber, checkPensionInsuranceNumber)
+	v.identifier("taxId", obj.TaxId, patternElevenDigits, checkTaxId)
+	v.enum("jobStatus", obj.JobStatus, customerJobStatuses)
+	v.nested("address", AssertAddressConstraints(obj.Address))
+	v.nested("bankDetails", AssertBankDetailsConstraints(obj.BankDetails))
+	return v.err()
 }
@@ -28,8 +28,10 @@ type CustomerRes struct {
 
 	BirthDate string `json:"birthDate"`
 
+	// Rentenversicherungsnummer: area number, date of birth as DDMMYY, initial of the birth name, serial number and check digit
 	SocialSecurityNumber string `json:"socialSecurityNumber"`
 
+	// Steuerliche Identifikationsnummer with a valid check digit, unique among all customers
 	TaxId string `json:"taxId"`
 
 	JobStatus string `json:"jobStatus"`
@@ -37,6 +39,13 @@ type CustomerRes struct {
 	Address Address `json:"address"`
 
 	BankDetails BankDetails `json:"bankDetails"`
+
+	// Employee advising the customer, by default the employee who created the customer
+	AdvisorId string `json:"advisorId,omitempty"`
+
+	Created *AuditStamp `json:"created,omitempty"`
+
+	Modified *AuditStamp `json:"modified,omitempty"`
 }
 
 // AssertCustomerResRequired checks if the required fields are not zero-ed
@@ -54,19 +63,12 @@ func AssertCustomerResRequired(obj CustomerRes) error {
 		"address": obj.Address,
 		"bankDetails": obj.BankDetails,
 	}
-	for name, el := range elements {
-		if isZero := IsZeroValue(el); isZero {
-			return &RequiredError{Field: name}
-		}
-	}
+	v := validator{}
+	v.required(elements)
 
-	if err := AssertAddressRequired(obj.Address); err != nil {
-		return err
-	}
-	if err := AssertBankDetailsRequired(obj.BankDetails); err != nil {
-		return err
-	}
-	return nil
+	v.nested("address", AssertAddressRequired(obj.Address))
+	v.nested("bankDetails", AssertBankDetailsRequired(obj.BankDetails))
+	return v.err()
 }
 
 // AssertCustomerResConstraints checks if the values respects the defined constraints
@@ -0,0 +1,69 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// DocumentKind : Kind of an uploaded document
+type DocumentKind string
+
+// List of DocumentKind
+const (
+	DocumentKindInvoice   DocumentKind = "invoice"
+	DocumentKindVetReport DocumentKind = "vetReport"
+	DocumentKindOther     DocumentKind = "other"
+)
+
+// AllowedDocumentKindEnumValues is all the allowed values of DocumentKind enum
+var AllowedDocumentKindEnumValues = []DocumentKind{
+	"invoice",
+	"vetReport",
+	"other",
+}
+
+// validDocumentKindEnumValue provides a map of DocumentKinds for fast verification of use input
+var validDocumentKindEnumValues = map[DocumentKind]struct{}{
+	"invoice":   {},
+	"vetReport": {},
+	"other":     {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v DocumentKind) IsValid() bool {
+	_, ok := validDocumentKindEnumValues[v]
+	return ok
+}
+
+// NewDocumentKindFromValue returns a pointer to a valid DocumentKind
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewDocumentKindFromValue(v string) (DocumentKind, error) {
+	ev := DocumentKind(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for DocumentKind: valid values are %v", v, AllowedDocumentKindEnumValues)
+}
+
+
+
+// AssertDocumentKindRequired checks if the required fields are not zero-ed
+func AssertDocumentKindRequired(obj DocumentKind) error {
+	return nil
+}
+
+// AssertDocumentKindConstraints checks if the values respects the defined constraints
+func AssertDocumentKindConstraints(obj DocumentKind) error {
+	return nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type DocumentRes struct {
+
+	Id string `json:"id"`
+
+	// Claim the document was uploaded to
+	ClaimId string `json:"claimId,omitempty"`
+
+	// Contract the document was uploaded to
+	ContractId string `json:"contractId,omitempty"`
+
+	Kind DocumentKind `json:"kind"`
+
+	FileName string `json:"fileName"`
+
+	// Media type sniffed from the content
+	ContentType string `json:"contentType"`
+
+	// Size of the content in bytes
+	Size int64 `json:"size"`
+
+	// SHA-256 of the content, hex encoded
+	Checksum string `json:"checksum"`
+
+	UploadedAt string `json:"uploadedAt"`
+}
+
+// AssertDocumentResRequired checks if the required fields are not zero-ed
+func AssertDocumentResRequired(obj DocumentRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"kind": obj.Kind,
+		"fileName": obj.FileName,
+		"contentType": obj.ContentType,
+		"size": obj.Size,
+		"checksum": obj.Checksum,
+		"uploadedAt": obj.UploadedAt,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertDocumentResConstraints checks if the values respects the defined constraints
+func AssertDocumentResConstraints(obj DocumentRes) error {
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// DunningCaseRes - Dunning of the overdue premiums of a contract whose direct debits were returned
+type DunningCaseRes struct {
+
+	Id string `json:"id"`
+
+	ContractId string `json:"contractId"`
+
+	CustomerId string `json:"customerId"`
+
+	Status DunningCaseStatus `json:"status"`
+
+	// Latest stage reached
+	Stage DunningStage `json:"stage,omitempty"`
+
+	// Returned installments dunned by the case
+	InstallmentIds []string `json:"installmentIds"`
+
+	// Premiums still outstanding in euros
+	Amount float32 `json:"amount"`
+
+	// Sum of the fees of all notices in euros
+	Fees float32 `json:"fees,omitempty"`
+
+	// Date the first direct debit of the case was returned
+	OpenedOn string `json:"openedOn"`
+
+	NextStage DunningStage `json:"nextStage,omitempty"`
+
+	// Date the next stage is reached unless the premiums are paid
+	NextStageOn string `json:"nextStageOn,omitempty"`
+
+	// Notices sent, oldest first
+	Notices []DunningNotice `json:"notices,omitempty"`
+
+	// Date all premiums of the case were paid
+	SettledOn string `json:"settledOn,omitempty"`
+}
+
+// AssertDunningCaseResRequired checks if the required fields are not zero-ed
+func AssertDunningCaseResRequired(obj DunningCaseRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"contractId": obj.ContractId,
+		"customerId": obj.CustomerId,
+		"status": obj.Status,
+		"installmentIds": obj.InstallmentIds,
+		"openedOn": obj.OpenedOn,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertDunningCaseResConstraints checks if the values respects the defined constraints
+func AssertDunningCaseResConstraints(obj DunningCaseRes) error {
+	return nil
+}
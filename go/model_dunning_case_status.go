@@ -0,0 +1,66 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// DunningCaseStatus : State of a dunning case, settled once its premiums are paid
+type DunningCaseStatus string
+
+// List of DunningCaseStatus
+const (
+	DunningCaseStatusOpen    DunningCaseStatus = "open"
+	DunningCaseStatusSettled DunningCaseStatus = "settled"
+)
+
+// AllowedDunningCaseStatusEnumValues is all the allowed values of DunningCaseStatus enum
+var AllowedDunningCaseStatusEnumValues = []DunningCaseStatus{
+	"open",
+	"settled",
+}
+
+// validDunningCaseStatusEnumValue provides a map of DunningCaseStatuss for fast verification of use input
+var validDunningCaseStatusEnumValues = map[DunningCaseStatus]struct{}{
+	"open":    {},
+	"settled": {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v DunningCaseStatus) IsValid() bool {
+	_, ok := validDunningCaseStatusEnumValues[v]
+	return ok
+}
+
+// NewDunningCaseStatusFromValue returns a pointer to a valid DunningCaseStatus
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewDunningCaseStatusFromValue(v string) (DunningCaseStatus, error) {
+	ev := DunningCaseStatus(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for DunningCaseStatus: valid values are %v", v, AllowedDunningCaseStatusEnumValues)
+}
+
+
+
+// AssertDunningCaseStatusRequired checks if the required fields are not zero-ed
+func AssertDunningCaseStatusRequired(obj DunningCaseStatus) error {
+	return nil
+}
+
+// AssertDunningCaseStatusConstraints checks if the values respects the defined constraints
+func AssertDunningCaseStatusConstraints(obj DunningCaseStatus) error {
+	return nil
+}
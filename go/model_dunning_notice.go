@@ -0,0 +1,51 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// DunningNotice - Notice sent to the customer when a dunning case reaches a stage
+type DunningNotice struct {
+
+	Stage DunningStage `json:"stage"`
+
+	IssuedOn string `json:"issuedOn"`
+
+	// Date the notice was sent to the customer, empty while sending it failed
+	SentOn string `json:"sentOn,omitempty"`
+
+	// Fee charged with the notice in euros
+	Fee float32 `json:"fee,omitempty"`
+
+	// Outstanding premiums and fees in euros at the time of the notice
+	Amount float32 `json:"amount"`
+
+	// Date by which the customer has to pay to avoid the next stage
+	PaymentDeadline string `json:"paymentDeadline,omitempty"`
+}
+
+// AssertDunningNoticeRequired checks if the required fields are not zero-ed
+func AssertDunningNoticeRequired(obj DunningNotice) error {
+	elements := map[string]interface{}{
+		"stage": obj.Stage,
+		"issuedOn": obj.IssuedOn,
+		"amount": obj.Amount,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertDunningNoticeConstraints checks if the values respects the defined constraints
+func AssertDunningNoticeConstraints(obj DunningNotice) error {
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// DunningStage : Stage of the dunning process for overdue premiums; the formal notice according to §38 VVG precedes the suspension of cover
+type DunningStage string
+
+// List of DunningStage
+const (
+	DunningStageReminder       DunningStage = "reminder"
+	DunningStageSecondReminder DunningStage = "secondReminder"
+	DunningStageFormalNotice   DunningStage = "formalNotice"
+	DunningStageSuspension     DunningStage = "suspension"
+)
+
+// AllowedDunningStageEnumValues is all the allowed values of DunningStage enum
+var AllowedDunningStageEnumValues = []DunningStage{
+	"reminder",
+	"secondReminder",
+	"formalNotice",
+	"suspension",
+}
+
+// validDunningStageEnumValue provides a map of DunningStages for fast verification of use input
+var validDunningStageEnumValues = map[DunningStage]struct{}{
+	"reminder":       {},
+	"secondReminder": {},
+	"formalNotice":   {},
+	"suspension":     {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v DunningStage) IsValid() bool {
+	_, ok := validDunningStageEnumValues[v]
+	return ok
+}
+
+// NewDunningStageFromValue returns a pointer to a valid DunningStage
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewDunningStageFromValue(v string) (DunningStage, error) {
+	ev := DunningStage(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for DunningStage: valid values are %v", v, AllowedDunningStageEnumValues)
+}
+
+
+
+// AssertDunningStageRequired checks if the required fields are not zero-ed
+func AssertDunningStageRequired(obj DunningStage) error {
+	return nil
+}
+
+// AssertDunningStageConstraints checks if the values respects the defined constraints
+func AssertDunningStageConstraints(obj DunningStage) error {
+	return nil
+}
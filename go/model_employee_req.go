@@ -19,6 +19,12 @@ type EmployeeReq struct {
 	LastName string `json:"lastName"`
 
 	Address Address `json:"address"`
+
+	// Email address the employee logs in with
+	Email string `json:"email"`
+
+	// Roles of the employee, employee if empty
+	Roles []EmployeeRole `json:"roles,omitempty"`
 }
 
 // AssertEmployeeReqRequired checks if the required fields are not zero-ed
@@ -27,20 +33,25 @@ func AssertEmployeeReqRequired(obj EmployeeReq) error {
 		"firstName": obj.FirstName,
 		"lastName": obj.LastName,
 		"address": obj.Address,
+		"email": obj.Email,
 	}
-	for name, el := range elements {
-		if isZero := IsZeroValue(el); isZero {
-			return &RequiredError{Field: name}
-		}
-	}
+	v := validator{}
+	v.required(elements)
 
-	if err := AssertAddressRequired(obj.Address); err != nil {
-		return err
-	}
-	return nil
+	v.nested("address", AssertAddressRequired(obj.Address))
+	return v.err()
 }
 
 // AssertEmployeeReqConstraints checks if the values respects the defined constraints
 func AssertEmployeeReqConstraints(obj EmployeeReq) error {
-	return nil
+	v := validator{}
+	v.nested("address", AssertAddressConstraints(obj.Address))
+	v.email("email", obj.Email)
+	for _, role := range obj.Roles {
+		if !role.IsValid() {
+			v.fail("roles", codeEnum, "must only contain %q", AllowedEmployeeRoleEnumValues)
+			break
+		}
+	}
+	return v.err()
 }
@@ -21,6 +21,16 @@ type EmployeeRes struct {
 	LastName string `json:"lastName"`
 
 	Address Address `json:"address"`
+
+	// Email address the employee logs in with
+	Email string `json:"email,omitempty"`
+
+	Roles []EmployeeRole `json:"roles"`
+
+	Status EmployeeStatus `json:"status"`
+
+	// Time the employee was deactivated
+	DeactivatedAt string `json:"deactivatedAt,omitempty"`
 }
 
 // AssertEmployeeResRequired checks if the required fields are not zero-ed
@@ -30,17 +40,14 @@ func AssertEmployeeResRequired(obj EmployeeRes) error {
 		"firstName": obj.FirstName,
 		"lastName": obj.LastName,
 		"address": obj.Address,
+		"roles": obj.Roles,
+		"status": obj.Status,
 	}
-	for name, el := range elements {
-		if isZero := IsZeroValue(el); isZero {
-			return &RequiredError{Field: name}
-		}
-	}
+	v := validator{}
+	v.required(elements)
 
-	if err := AssertAddressRequired(obj.Address); err != nil {
-		return err
-	}
-	return nil
+	v.nested("address", AssertAddressRequired(obj.Address))
+	return v.err()
 }
 
 // AssertEmployeeResConstraints checks if the values respects the defined constraints
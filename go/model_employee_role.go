@@ -0,0 +1,66 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// EmployeeRole : Role of an employee, granted to the sessions of its account; admins may call every route
+type EmployeeRole string
+
+// List of EmployeeRole
+const (
+	EmployeeRoleEmployee EmployeeRole = "employee"
+	EmployeeRoleAdmin    EmployeeRole = "admin"
+)
+
+// AllowedEmployeeRoleEnumValues is all the allowed values of EmployeeRole enum
+var AllowedEmployeeRoleEnumValues = []EmployeeRole{
+	"employee",
+	"admin",
+}
+
+// validEmployeeRoleEnumValue provides a map of EmployeeRoles for fast verification of use input
+var validEmployeeRoleEnumValues = map[EmployeeRole]struct{}{
+	"employee": {},
+	"admin":    {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v EmployeeRole) IsValid() bool {
+	_, ok := validEmployeeRoleEnumValues[v]
+	return ok
+}
+
+// NewEmployeeRoleFromValue returns a pointer to a valid EmployeeRole
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewEmployeeRoleFromValue(v string) (EmployeeRole, error) {
+	ev := EmployeeRole(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for EmployeeRole: valid values are %v", v, AllowedEmployeeRoleEnumValues)
+}
+
+
+
+// AssertEmployeeRoleRequired checks if the required fields are not zero-ed
+func AssertEmployeeRoleRequired(obj EmployeeRole) error {
+	return nil
+}
+
+// AssertEmployeeRoleConstraints checks if the values respects the defined constraints
+func AssertEmployeeRoleConstraints(obj EmployeeRole) error {
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// EmployeeStatus : State of an employee, inactive once deactivated, e.g. after leaving the company
+type EmployeeStatus string
+
+// List of EmployeeStatus
+const (
+	EmployeeStatusActive   EmployeeStatus = "active"
+	EmployeeStatusInactive EmployeeStatus = "inactive"
+)
+
+// AllowedEmployeeStatusEnumValues is all the allowed values of EmployeeStatus enum
+var AllowedEmployeeStatusEnumValues = []EmployeeStatus{
+	"active",
+	"inactive",
+}
+
+// validEmployeeStatusEnumValue provides a map of EmployeeStatuss for fast verification of use input
+var validEmployeeStatusEnumValues = map[EmployeeStatus]struct{}{
+	"active":   {},
+	"inactive": {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v EmployeeStatus) IsValid() bool {
+	_, ok := validEmployeeStatusEnumValues[v]
+	return ok
+}
+
+// NewEmployeeStatusFromValue returns a pointer to a valid EmployeeStatus
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewEmployeeStatusFromValue(v string) (EmployeeStatus, error) {
+	ev := EmployeeStatus(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for EmployeeStatus: valid values are %v", v, AllowedEmployeeStatusEnumValues)
+}
+
+
+
+// AssertEmployeeStatusRequired checks if the required fields are not zero-ed
+func AssertEmployeeStatusRequired(obj EmployeeStatus) error {
+	return nil
+}
+
+// AssertEmployeeStatusConstraints checks if the values respects the defined constraints
+func AssertEmployeeStatusConstraints(obj EmployeeStatus) error {
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// EmployeeUpdateReq - Changes of an employee, values not given stay unchanged
+type EmployeeUpdateReq struct {
+
+	FirstName *string `json:"firstName,omitempty"`
+
+	LastName *string `json:"lastName,omitempty"`
+
+	Address *Address `json:"address,omitempty"`
+
+	// Email address the employee logs in with
+	Email *string `json:"email,omitempty"`
+
+	// Roles of the employee, replacing the previous ones
+	Roles []EmployeeRole `json:"roles,omitempty"`
+}
+
+// AssertEmployeeUpdateReqRequired checks if the required fields are not zero-ed
+func AssertEmployeeUpdateReqRequired(obj EmployeeUpdateReq) error {
+	elements := map[string]interface{}{
+	}
+	v := validator{}
+	v.required(elements)
+
+	if obj.Address != nil {
+		v.nested("address", AssertAddressRequired(*obj.Address))
+	}
+	return v.err()
+}
+
+// AssertEmployeeUpdateReqConstraints checks if the values respects the defined constraints
+func AssertEmployeeUpdateReqConstraints(obj EmployeeUpdateReq) error {
+	v := validator{}
+	if obj.Address != nil {
+		v.nested("address", AssertAddressConstraints(*obj.Address))
+	}
+	if obj.Email != nil {
+		v.email("email", *obj.Email)
+	}
+	if obj.Roles != nil && len(obj.Roles) == 0 {
+		v.fail("roles", codeInvalid, "must contain at least one role")
+	}
+	for _, role := range obj.Roles {
+		if !role.IsValid() {
+			v.fail("roles", codeEnum, "must only contain %q", AllowedEmployeeRoleEnumValues)
+			break
+		}
+	}
+	return v.err()
+}
@@ -0,0 +1,49 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// InstallmentPlan - Installments a yearly premium is paid in with a payment frequency
+type InstallmentPlan struct {
+
+	PaymentFrequency PaymentFrequency `json:"paymentFrequency"`
+
+	InstallmentsPerYear int32 `json:"installmentsPerYear"`
+
+	// Premium due per installment, including the surcharge
+	InstallmentAmount float32 `json:"installmentAmount"`
+
+	// Part of the installment amount that is surcharge for paying in installments
+	Surcharge float32 `json:"surcharge,omitempty"`
+
+	// Sum of the installments of a year
+	YearlyAmount float32 `json:"yearlyAmount"`
+}
+
+// AssertInstallmentPlanRequired checks if the required fields are not zero-ed
+func AssertInstallmentPlanRequired(obj InstallmentPlan) error {
+	elements := map[string]interface{}{
+		"paymentFrequency": obj.PaymentFrequency,
+		"installmentsPerYear": obj.InstallmentsPerYear,
+		"installmentAmount": obj.InstallmentAmount,
+		"yearlyAmount": obj.YearlyAmount,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertInstallmentPlanConstraints checks if the values respects the defined constraints
+func AssertInstallmentPlanConstraints(obj InstallmentPlan) error {
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// InstallmentRes - Premium of a contract falling due on a date
+type InstallmentRes struct {
+
+	Id string `json:"id"`
+
+	ContractId string `json:"contractId"`
+
+	CustomerId string `json:"customerId"`
+
+	DueDate string `json:"dueDate"`
+
+	// Amount in euros
+	Amount float32 `json:"amount"`
+
+	// Part of the amount that is surcharge for paying in installments
+	Surcharge float32 `json:"surcharge,omitempty"`
+
+	Status InstallmentStatus `json:"status"`
+
+	// Billing run that submitted the installment for collection
+	BillingRunId string `json:"billingRunId,omitempty"`
+
+	// Mandate the installment is collected with
+	MandateId string `json:"mandateId,omitempty"`
+
+	MandateReference string `json:"mandateReference,omitempty"`
+
+	// End-to-end identification of the direct debit, returned by the bank in statements and returns
+	EndToEndId string `json:"endToEndId,omitempty"`
+
+	SequenceType MandateSequenceType `json:"sequenceType,omitempty"`
+
+	// Date the debtor's account is debited
+	CollectionDate string `json:"collectionDate,omitempty"`
+
+	// Booking date of the payment on the creditor's account
+	PaymentDate string `json:"paymentDate,omitempty"`
+
+	// Booking date of the return of the direct debit
+	ReturnDate string `json:"returnDate,omitempty"`
+
+	// ISO 20022 reason code of the return, e.g. AM04 for insufficient funds or MD06 for a refund requested by the debtor
+	ReturnReason string `json:"returnReason,omitempty"`
+}
+
+// AssertInstallmentResRequired checks if the required fields are not zero-ed
+func AssertInstallmentResRequired(obj InstallmentRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"contractId": obj.ContractId,
+		"customerId": obj.CustomerId,
+		"dueDate": obj.DueDate,
+		"amount": obj.Amount,
+		"status": obj.Status,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertInstallmentResConstraints checks if the values respects the defined constraints
+func AssertInstallmentResConstraints(obj InstallmentRes) error {
+	return nil
+}
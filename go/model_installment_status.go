@@ -0,0 +1,72 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// InstallmentStatus : Collection state of a premium installment
+type InstallmentStatus string
+
+// List of InstallmentStatus
+const (
+	InstallmentStatusOpen      InstallmentStatus = "open"
+	InstallmentStatusSubmitted InstallmentStatus = "submitted"
+	InstallmentStatusPaid      InstallmentStatus = "paid"
+	InstallmentStatusReturned  InstallmentStatus = "returned"
+)
+
+// AllowedInstallmentStatusEnumValues is all the allowed values of InstallmentStatus enum
+var AllowedInstallmentStatusEnumValues = []InstallmentStatus{
+	"open",
+	"submitted",
+	"paid",
+	"returned",
+}
+
+// validInstallmentStatusEnumValue provides a map of InstallmentStatuss for fast verification of use input
+var validInstallmentStatusEnumValues = map[InstallmentStatus]struct{}{
+	"open":      {},
+	"submitted": {},
+	"paid":      {},
+	"returned":  {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v InstallmentStatus) IsValid() bool {
+	_, ok := validInstallmentStatusEnumValues[v]
+	return ok
+}
+
+// NewInstallmentStatusFromValue returns a pointer to a valid InstallmentStatus
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewInstallmentStatusFromValue(v string) (InstallmentStatus, error) {
+	ev := InstallmentStatus(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for InstallmentStatus: valid values are %v", v, AllowedInstallmentStatusEnumValues)
+}
+
+
+
+// AssertInstallmentStatusRequired checks if the required fields are not zero-ed
+func AssertInstallmentStatusRequired(obj InstallmentStatus) error {
+	return nil
+}
+
+// AssertInstallmentStatusConstraints checks if the values respects the defined constraints
+func AssertInstallmentStatusConstraints(obj InstallmentStatus) error {
+	return nil
+}
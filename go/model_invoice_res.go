@@ -0,0 +1,73 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// InvoiceRes - Premium invoice for one installment period of a contract
+type InvoiceRes struct {
+
+	// Invoice number, the first block of the contract id followed by the position of the installment in the schedule
+	Number string `json:"number"`
+
+	ContractId string `json:"contractId"`
+
+	CustomerId string `json:"customerId"`
+
+	// Installment collecting the invoice, set once the invoice fell due
+	InstallmentId string `json:"installmentId,omitempty"`
+
+	DueDate string `json:"dueDate"`
+
+	// First day covered by the invoice
+	PeriodStart string `json:"periodStart"`
+
+	// Last day covered by the invoice
+	PeriodEnd string `json:"periodEnd"`
+
+	PaymentFrequency PaymentFrequency `json:"paymentFrequency"`
+
+	// Share of the yearly premium in euros
+	Premium float32 `json:"premium"`
+
+	// Surcharge for paying in installments in euros
+	Surcharge float32 `json:"surcharge,omitempty"`
+
+	// Amount due in euros
+	Amount float32 `json:"amount"`
+
+	Status InstallmentStatus `json:"status"`
+}
+
+// AssertInvoiceResRequired checks if the required fields are not zero-ed
+func AssertInvoiceResRequired(obj InvoiceRes) error {
+	elements := map[string]interface{}{
+		"number": obj.Number,
+		"contractId": obj.ContractId,
+		"customerId": obj.CustomerId,
+		"dueDate": obj.DueDate,
+		"periodStart": obj.PeriodStart,
+		"periodEnd": obj.PeriodEnd,
+		"paymentFrequency": obj.PaymentFrequency,
+		"premium": obj.Premium,
+		"amount": obj.Amount,
+		"status": obj.Status,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertInvoiceResConstraints checks if the values respects the defined constraints
+func AssertInvoiceResConstraints(obj InvoiceRes) error {
+	return nil
+}
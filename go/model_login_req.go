@@ -0,0 +1,37 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type LoginReq struct {
+
+	Email string `json:"email"`
+
+	Password string `json:"password"`
+}
+
+// AssertLoginReqRequired checks if the required fields are not zero-ed
+func AssertLoginReqRequired(obj LoginReq) error {
+	elements := map[string]interface{}{
+		"email": obj.Email,
+		"password": obj.Password,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertLoginReqConstraints checks if the values respects the defined constraints
+func AssertLoginReqConstraints(obj LoginReq) error {
+	return nil
+}
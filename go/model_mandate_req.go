@@ -0,0 +1,42 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type MandateReq struct {
+
+	// Contract whose premiums are collected with the mandate
+	ContractId string `json:"contractId"`
+
+	// Date the customer signed the mandate
+	SignatureDate string `json:"signatureDate"`
+}
+
+// AssertMandateReqRequired checks if the required fields are not zero-ed
+func AssertMandateReqRequired(obj MandateReq) error {
+	elements := map[string]interface{}{
+		"contractId": obj.ContractId,
+		"signatureDate": obj.SignatureDate,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertMandateReqConstraints checks if the values respects the defined constraints
+func AssertMandateReqConstraints(obj MandateReq) error {
+	v := validator{}
+	v.uuid("contractId", obj.ContractId)
+	v.date("signatureDate", obj.SignatureDate)
+	return v.err()
+}
@@ -0,0 +1,78 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// MandateRes - SEPA direct debit mandate authorizing the collection of the premiums of a contract
+type MandateRes struct {
+
+	Id string `json:"id"`
+
+	// Unique mandate reference quoted in every direct debit
+	Reference string `json:"reference"`
+
+	CustomerId string `json:"customerId"`
+
+	ContractId string `json:"contractId"`
+
+	// Gläubiger-Identifikationsnummer of the insurer
+	CreditorId string `json:"creditorId"`
+
+	// Account the mandate was signed for
+	Iban string `json:"iban"`
+
+	Bic string `json:"bic,omitempty"`
+
+	// Name of the account holder
+	AccountHolder string `json:"accountHolder"`
+
+	SignatureDate string `json:"signatureDate"`
+
+	Status MandateStatus `json:"status"`
+
+	SequenceType MandateSequenceType `json:"sequenceType"`
+
+	CreatedAt string `json:"createdAt"`
+
+	// Date of the last direct debit collected with the mandate
+	LastCollectionDate string `json:"lastCollectionDate,omitempty"`
+
+	RevokedAt string `json:"revokedAt,omitempty"`
+
+	RevocationReason string `json:"revocationReason,omitempty"`
+}
+
+// AssertMandateResRequired checks if the required fields are not zero-ed
+func AssertMandateResRequired(obj MandateRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"reference": obj.Reference,
+		"customerId": obj.CustomerId,
+		"contractId": obj.ContractId,
+		"creditorId": obj.CreditorId,
+		"iban": obj.Iban,
+		"accountHolder": obj.AccountHolder,
+		"signatureDate": obj.SignatureDate,
+		"status": obj.Status,
+		"sequenceType": obj.SequenceType,
+		"createdAt": obj.CreatedAt,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertMandateResConstraints checks if the values respects the defined constraints
+func AssertMandateResConstraints(obj MandateRes) error {
+	return nil
+}
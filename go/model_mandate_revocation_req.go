@@ -0,0 +1,35 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type MandateRevocationReq struct {
+
+	// Why the mandate is revoked, e.g. on request of the customer
+	Reason string `json:"reason"`
+}
+
+// AssertMandateRevocationReqRequired checks if the required fields are not zero-ed
+func AssertMandateRevocationReqRequired(obj MandateRevocationReq) error {
+	elements := map[string]interface{}{
+		"reason": obj.Reason,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertMandateRevocationReqConstraints checks if the values respects the defined constraints
+func AssertMandateRevocationReqConstraints(obj MandateRevocationReq) error {
+	return nil
+}
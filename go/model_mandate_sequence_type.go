@@ -0,0 +1,66 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// MandateSequenceType : SEPA sequence type of the next direct debit collected with a mandate, FRST for the first and RCUR for every following one
+type MandateSequenceType string
+
+// List of MandateSequenceType
+const (
+	MandateSequenceTypeFrst MandateSequenceType = "FRST"
+	MandateSequenceTypeRcur MandateSequenceType = "RCUR"
+)
+
+// AllowedMandateSequenceTypeEnumValues is all the allowed values of MandateSequenceType enum
+var AllowedMandateSequenceTypeEnumValues = []MandateSequenceType{
+	"FRST",
+	"RCUR",
+}
+
+// validMandateSequenceTypeEnumValue provides a map of MandateSequenceTypes for fast verification of use input
+var validMandateSequenceTypeEnumValues = map[MandateSequenceType]struct{}{
+	"FRST": {},
+	"RCUR": {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v MandateSequenceType) IsValid() bool {
+	_, ok := validMandateSequenceTypeEnumValues[v]
+	return ok
+}
+
+// NewMandateSequenceTypeFromValue returns a pointer to a valid MandateSequenceType
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewMandateSequenceTypeFromValue(v string) (MandateSequenceType, error) {
+	ev := MandateSequenceType(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for MandateSequenceType: valid values are %v", v, AllowedMandateSequenceTypeEnumValues)
+}
+
+
+
+// AssertMandateSequenceTypeRequired checks if the required fields are not zero-ed
+func AssertMandateSequenceTypeRequired(obj MandateSequenceType) error {
+	return nil
+}
+
+// AssertMandateSequenceTypeConstraints checks if the values respects the defined constraints
+func AssertMandateSequenceTypeConstraints(obj MandateSequenceType) error {
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// MandateStatus : State of a SEPA direct debit mandate. Mandates expire when they are not used for 36 months.
+type MandateStatus string
+
+// List of MandateStatus
+const (
+	MandateStatusActive  MandateStatus = "active"
+	MandateStatusRevoked MandateStatus = "revoked"
+	MandateStatusExpired MandateStatus = "expired"
+)
+
+// AllowedMandateStatusEnumValues is all the allowed values of MandateStatus enum
+var AllowedMandateStatusEnumValues = []MandateStatus{
+	"active",
+	"revoked",
+	"expired",
+}
+
+// validMandateStatusEnumValue provides a map of MandateStatuss for fast verification of use input
+var validMandateStatusEnumValues = map[MandateStatus]struct{}{
+	"active":  {},
+	"revoked": {},
+	"expired": {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v MandateStatus) IsValid() bool {
+	_, ok := validMandateStatusEnumValues[v]
+	return ok
+}
+
+// NewMandateStatusFromValue returns a pointer to a valid MandateStatus
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewMandateStatusFromValue(v string) (MandateStatus, error) {
+	ev := MandateStatus(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for MandateStatus: valid values are %v", v, AllowedMandateStatusEnumValues)
+}
+
+
+
+// AssertMandateStatusRequired checks if the required fields are not zero-ed
+func AssertMandateStatusRequired(obj MandateStatus) error {
+	return nil
+}
+
+// AssertMandateStatusConstraints checks if the values respects the defined constraints
+func AssertMandateStatusConstraints(obj MandateStatus) error {
+	return nil
+}
@@ -0,0 +1,41 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type PasswordResetConfirmationReq struct {
+
+	// Token sent to the email address of the account
+	Token string `json:"token"`
+
+	// New password
+	Password string `json:"password"`
+}
+
+// AssertPasswordResetConfirmationReqRequired checks if the required fields are not zero-ed
+func AssertPasswordResetConfirmationReqRequired(obj PasswordResetConfirmationReq) error {
+	elements := map[string]interface{}{
+		"token": obj.Token,
+		"password": obj.Password,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertPasswordResetConfirmationReqConstraints checks if the values respects the defined constraints
+func AssertPasswordResetConfirmationReqConstraints(obj PasswordResetConfirmationReq) error {
+	v := validator{}
+	v.length("password", obj.Password, 12, 128)
+	return v.err()
+}
@@ -0,0 +1,37 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type PasswordResetReq struct {
+
+	// Email address of the account
+	Email string `json:"email"`
+}
+
+// AssertPasswordResetReqRequired checks if the required fields are not zero-ed
+func AssertPasswordResetReqRequired(obj PasswordResetReq) error {
+	elements := map[string]interface{}{
+		"email": obj.Email,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertPasswordResetReqConstraints checks if the values respects the defined constraints
+func AssertPasswordResetReqConstraints(obj PasswordResetReq) error {
+	v := validator{}
+	v.email("email", obj.Email)
+	return v.err()
+}
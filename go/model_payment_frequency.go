@@ -0,0 +1,69 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// PaymentFrequency : How often the premium of a contract is paid; monthly and quarterly payments carry a surcharge defined by the tariff
+type PaymentFrequency string
+
+// List of PaymentFrequency
+const (
+	PaymentFrequencyMonthly   PaymentFrequency = "monthly"
+	PaymentFrequencyQuarterly PaymentFrequency = "quarterly"
+	PaymentFrequencyYearly    PaymentFrequency = "yearly"
+)
+
+// AllowedPaymentFrequencyEnumValues is all the allowed values of PaymentFrequency enum
+var AllowedPaymentFrequencyEnumValues = []PaymentFrequency{
+	"monthly",
+	"quarterly",
+	"yearly",
+}
+
+// validPaymentFrequencyEnumValue provides a map of PaymentFrequencys for fast verification of use input
+var validPaymentFrequencyEnumValues = map[PaymentFrequency]struct{}{
+	"monthly":   {},
+	"quarterly": {},
+	"yearly":    {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v PaymentFrequency) IsValid() bool {
+	_, ok := validPaymentFrequencyEnumValues[v]
+	return ok
+}
+
+// NewPaymentFrequencyFromValue returns a pointer to a valid PaymentFrequency
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewPaymentFrequencyFromValue(v string) (PaymentFrequency, error) {
+	ev := PaymentFrequency(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for PaymentFrequency: valid values are %v", v, AllowedPaymentFrequencyEnumValues)
+}
+
+
+
+// AssertPaymentFrequencyRequired checks if the required fields are not zero-ed
+func AssertPaymentFrequencyRequired(obj PaymentFrequency) error {
+	return nil
+}
+
+// AssertPaymentFrequencyConstraints checks if the values respects the defined constraints
+func AssertPaymentFrequencyConstraints(obj PaymentFrequency) error {
+	return nil
+}
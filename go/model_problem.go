@@ -0,0 +1,64 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+
+// Problem - Error response as defined by RFC 7807
+type Problem struct {
+
+	// URI reference identifying the problem type
+	Type string `json:"type"`
+
+	// Short summary of the problem type
+	Title string `json:"title"`
+
+	// HTTP status code of the response
+	Status int32 `json:"status"`
+
+	// Explanation specific to this occurrence of the problem
+	Detail string `json:"detail,omitempty"`
+
+	// Path of the request the problem occurred in
+	Instance string `json:"instance,omitempty"`
+
+	// Id correlating the response with the server logs
+	TraceId string `json:"traceId"`
+
+	// Invalid or missing values of the request
+	Errors []ProblemError `json:"errors,omitempty"`
+}
+
+// AssertProblemRequired checks if the required fields are not zero-ed
+func AssertProblemRequired(obj Problem) error {
+	elements := map[string]interface{}{
+		"type": obj.Type,
+		"title": obj.Title,
+		"status": obj.Status,
+		"traceId": obj.TraceId,
+	}
+	v := validator{}
+	v.required(elements)
+
+	for i, el := range obj.Errors {
+		v.nested(fmt.Sprintf("errors[%d]", i), AssertProblemErrorRequired(el))
+	}
+	return v.err()
+}
+
+// AssertProblemConstraints checks if the values respects the defined constraints
+func AssertProblemConstraints(obj Problem) error {
+	return nil
+}
@@ -0,0 +1,43 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// ProblemError - A missing or invalid value of a request
+type ProblemError struct {
+
+	// JSON path of the value in the request body, e.g. $.address.zipCode, or the name of the parameter
+	Field string `json:"field"`
+
+	// Kind of the error
+	Code string `json:"code"`
+
+	Message string `json:"message"`
+}
+
+// AssertProblemErrorRequired checks if the required fields are not zero-ed
+func AssertProblemErrorRequired(obj ProblemError) error {
+	elements := map[string]interface{}{
+		"field": obj.Field,
+		"code": obj.Code,
+		"message": obj.Message,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertProblemErrorConstraints checks if the values respects the defined constraints
+func AssertProblemErrorConstraints(obj ProblemError) error {
+	return nil
+}
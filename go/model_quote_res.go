@@ -0,0 +1,67 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"errors"
+)
+
+
+
+type QuoteRes struct {
+
+	Id string `json:"id"`
+
+	Rate float32 `json:"rate"`
+
+	TariffVersion string `json:"tariffVersion"`
+
+	CreatedAt string `json:"createdAt"`
+
+	ValidUntil string `json:"validUntil"`
+
+	// The cat the rate was calculated for
+	Request RateCalculationReq `json:"request"`
+
+	// Id of the contract created from this quote
+	ContractId string `json:"contractId,omitempty"`
+}
+
+// AssertQuoteResRequired checks if the required fields are not zero-ed
+func AssertQuoteResRequired(obj QuoteRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"rate": obj.Rate,
+		"tariffVersion": obj.TariffVersion,
+		"createdAt": obj.CreatedAt,
+		"validUntil": obj.ValidUntil,
+		"request": obj.Request,
+	}
+	v := validator{}
+	v.required(elements)
+
+	v.nested("request", AssertRateCalculationReqRequired(obj.Request))
+	return v.err()
+}
+
+// AssertQuoteResConstraints checks if the values respects the defined constraints
+func AssertQuoteResConstraints(obj QuoteRes) error {
+	if obj.Rate < 0 {
+		return &ParsingError{Err: errors.New(errMsgMinValueConstraint)}
+	}
+	if obj.Rate > 99999 {
+		return &ParsingError{Err: errors.New(errMsgMaxValueConstraint)}
+	}
+	if err := AssertRateCalculationReqConstraints(obj.Request); err != nil {
+		return err
+	}
+	return nil
+}
@@ -10,10 +10,6 @@
 package openapi
 
 
-import (
-	"errors"
-)
-
 
 
 type RateCalculationReq struct {
@@ -45,34 +41,29 @@ func AssertRateCalculationReqRequired(obj RateCalculationReq) error {
 		"breed": obj.Breed,
 		"color": obj.Color,
 		"birthDate": obj.BirthDate,
-		"neutered": obj.Neutered,
 		"personality": obj.Personality,
 		"environment": obj.Environment,
 		"weight": obj.Weight,
 		"zipCode": obj.ZipCode,
 	}
-	for name, el := range elements {
-		if isZero := IsZeroValue(el); isZero {
-			return &RequiredError{Field: name}
-		}
-	}
+	// neutered is not checked, false is a valid value for a required boolean
+	v := validator{}
+	v.required(elements)
 
-	return nil
+	return v.err()
 }
 
 // AssertRateCalculationReqConstraints checks if the values respects the defined constraints
 func AssertRateCalculationReqConstraints(obj RateCalculationReq) error {
-	if obj.Coverage < 1 {
-		return &ParsingError{Err: errors.New(errMsgMinValueConstraint)}
-	}
-	if obj.Weight < 50 {
-		return &ParsingError{Err: errors.New(errMsgMinValueConstraint)}
-	}
-	if obj.ZipCode < 0 {
-		return &ParsingError{Err: errors.New(errMsgMinValueConstraint)}
-	}
-	if obj.ZipCode > 99999 {
-		return &ParsingError{Err: errors.New(errMsgMaxValueConstraint)}
-	}
-	return nil
+	v := validator{}
+	v.minimum("coverage", float64(obj.Coverage), 1)
+	v.pattern("breed", obj.Breed, patternCapitalized)
+	v.pattern("color", obj.Color, patternCapitalized)
+	v.date("birthDate", obj.BirthDate)
+	v.pattern("personality", obj.Personality, patternCapitalized)
+	v.pattern("environment", obj.Environment, patternCapitalized)
+	v.minimum("weight", float64(obj.Weight), 50)
+	v.minimum("zipCode", float64(obj.ZipCode), 0)
+	v.maximum("zipCode", float64(obj.ZipCode), 99999)
+	return v.err()
 }
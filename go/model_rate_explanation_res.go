@@ -0,0 +1,73 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"errors"
+)
+
+
+
+type RateExplanationRes struct {
+
+	TariffVersion string `json:"tariffVersion"`
+
+	// Yearly premium before coverage and factors
+	BaseRate float32 `json:"baseRate"`
+
+	// Premium added for the requested coverage
+	CoveragePremium float32 `json:"coveragePremium"`
+
+	// Sum of baseRate and coveragePremium
+	BasePremium float32 `json:"basePremium"`
+
+	// Multiplicative factors applied to the base premium, in the order they were applied
+	Factors []RateFactor `json:"factors"`
+
+	// Premium after all factors, before the tariff bounds were applied
+	UncappedRate float32 `json:"uncappedRate"`
+
+	MinimumRate float32 `json:"minimumRate"`
+
+	MaximumRate float32 `json:"maximumRate"`
+
+	// Tariff bound applied to the uncapped rate, if any
+	CapApplied string `json:"capApplied,omitempty"`
+
+	// Final yearly premium, identical to the rate returned by calculateRate
+	Rate float32 `json:"rate"`
+
+	// Installments of the rate for every payment frequency, with the surcharges of the tariff
+	Installments []InstallmentPlan `json:"installments,omitempty"`
+}
+
+// AssertRateExplanationResRequired checks if the required fields are not zero-ed
+func AssertRateExplanationResRequired(obj RateExplanationRes) error {
+	elements := map[string]interface{}{
+		"tariffVersion": obj.TariffVersion,
+		"factors": obj.Factors,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertRateExplanationResConstraints checks if the values respects the defined constraints
+func AssertRateExplanationResConstraints(obj RateExplanationRes) error {
+	if obj.Rate < 0 {
+		return &ParsingError{Err: errors.New(errMsgMinValueConstraint)}
+	}
+	if obj.Rate > 99999 {
+		return &ParsingError{Err: errors.New(errMsgMaxValueConstraint)}
+	}
+	return nil
+}
@@ -19,6 +19,15 @@ import (
 type RateRes struct {
 
 	Rate float32 `json:"rate,omitempty"`
+
+	// Id of the saved quote, only set if saveQuote was requested
+	QuoteId string `json:"quoteId,omitempty"`
+
+	// Time until which the saved quote can be referenced by createContract
+	ValidUntil string `json:"validUntil,omitempty"`
+
+	// Installments of the rate for every payment frequency
+	Installments []InstallmentPlan `json:"installments,omitempty"`
 }
 
 // AssertRateResRequired checks if the required fields are not zero-ed
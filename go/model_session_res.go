@@ -0,0 +1,42 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// SessionRes - Session token to send as bearer token in the Authorization header
+type SessionRes struct {
+
+	Token string `json:"token"`
+
+	// Always Bearer
+	TokenType string `json:"tokenType"`
+
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// AssertSessionResRequired checks if the required fields are not zero-ed
+func AssertSessionResRequired(obj SessionRes) error {
+	elements := map[string]interface{}{
+		"token": obj.Token,
+		"tokenType": obj.TokenType,
+		"expiresAt": obj.ExpiresAt,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertSessionResConstraints checks if the values respects the defined constraints
+func AssertSessionResConstraints(obj SessionRes) error {
+	return nil
+}
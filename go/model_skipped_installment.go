@@ -0,0 +1,47 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// SkippedInstallment - Installment that is due but cannot be collected by direct debit
+type SkippedInstallment struct {
+
+	InstallmentId string `json:"installmentId"`
+
+	ContractId string `json:"contractId"`
+
+	DueDate string `json:"dueDate"`
+
+	Amount float32 `json:"amount"`
+
+	Reason string `json:"reason"`
+}
+
+// AssertSkippedInstallmentRequired checks if the required fields are not zero-ed
+func AssertSkippedInstallmentRequired(obj SkippedInstallment) error {
+	elements := map[string]interface{}{
+		"installmentId": obj.InstallmentId,
+		"contractId": obj.ContractId,
+		"dueDate": obj.DueDate,
+		"amount": obj.Amount,
+		"reason": obj.Reason,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertSkippedInstallmentConstraints checks if the values respects the defined constraints
+func AssertSkippedInstallmentConstraints(obj SkippedInstallment) error {
+	return nil
+}
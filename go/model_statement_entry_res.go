@@ -0,0 +1,80 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+// StatementEntryRes - Credit or debit of a bank statement and the installments it was matched to
+type StatementEntryRes struct {
+
+	Id string `json:"id"`
+
+	BankStatementId string `json:"bankStatementId"`
+
+	BookingDate string `json:"bookingDate"`
+
+	ValueDate string `json:"valueDate,omitempty"`
+
+	// Amount in euros
+	Amount float32 `json:"amount"`
+
+	CreditDebit CreditDebitIndicator `json:"creditDebit"`
+
+	EndToEndId string `json:"endToEndId,omitempty"`
+
+	MandateReference string `json:"mandateReference,omitempty"`
+
+	// PmtInfId of a batch booked as a single entry
+	PaymentInformationId string `json:"paymentInformationId,omitempty"`
+
+	// Name of the debtor of the direct debit or transfer
+	CounterpartyName string `json:"counterpartyName,omitempty"`
+
+	CounterpartyIban string `json:"counterpartyIban,omitempty"`
+
+	RemittanceInformation string `json:"remittanceInformation,omitempty"`
+
+	// ISO 20022 reason code of a returned direct debit
+	ReturnReason string `json:"returnReason,omitempty"`
+
+	Status StatementEntryStatus `json:"status"`
+
+	// Why the entry could not be matched
+	UnmatchedReason string `json:"unmatchedReason,omitempty"`
+
+	// Installments paid or returned by the entry
+	InstallmentIds []string `json:"installmentIds,omitempty"`
+
+	ResolutionNote string `json:"resolutionNote,omitempty"`
+
+	ResolvedAt string `json:"resolvedAt,omitempty"`
+}
+
+// AssertStatementEntryResRequired checks if the required fields are not zero-ed
+func AssertStatementEntryResRequired(obj StatementEntryRes) error {
+	elements := map[string]interface{}{
+		"id": obj.Id,
+		"bankStatementId": obj.BankStatementId,
+		"bookingDate": obj.BookingDate,
+		"amount": obj.Amount,
+		"creditDebit": obj.CreditDebit,
+		"status": obj.Status,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertStatementEntryResConstraints checks if the values respects the defined constraints
+func AssertStatementEntryResConstraints(obj StatementEntryRes) error {
+	return nil
+}
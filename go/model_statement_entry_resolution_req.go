@@ -0,0 +1,40 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+
+
+type StatementEntryResolutionReq struct {
+
+	// Installment paid or returned by the entry; the entry is dismissed if empty
+	InstallmentId string `json:"installmentId,omitempty"`
+
+	// How the entry was resolved, e.g. a transfer of the customer or bank charges
+	Note string `json:"note"`
+}
+
+// AssertStatementEntryResolutionReqRequired checks if the required fields are not zero-ed
+func AssertStatementEntryResolutionReqRequired(obj StatementEntryResolutionReq) error {
+	elements := map[string]interface{}{
+		"note": obj.Note,
+	}
+	v := validator{}
+	v.required(elements)
+
+	return v.err()
+}
+
+// AssertStatementEntryResolutionReqConstraints checks if the values respects the defined constraints
+func AssertStatementEntryResolutionReqConstraints(obj StatementEntryResolutionReq) error {
+	v := validator{}
+	v.uuid("installmentId", obj.InstallmentId)
+	return v.err()
+}
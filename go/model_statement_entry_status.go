@@ -0,0 +1,72 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+
+import (
+	"fmt"
+)
+
+
+// StatementEntryStatus : Reconciliation state of a bank statement entry. Unmatched entries are resolved by an employee.
+type StatementEntryStatus string
+
+// List of StatementEntryStatus
+const (
+	StatementEntryStatusMatched   StatementEntryStatus = "matched"
+	StatementEntryStatusUnmatched StatementEntryStatus = "unmatched"
+	StatementEntryStatusResolved  StatementEntryStatus = "resolved"
+	StatementEntryStatusDismissed StatementEntryStatus = "dismissed"
+)
+
+// AllowedStatementEntryStatusEnumValues is all the allowed values of StatementEntryStatus enum
+var AllowedStatementEntryStatusEnumValues = []StatementEntryStatus{
+	"matched",
+	"unmatched",
+	"resolved",
+	"dismissed",
+}
+
+// validStatementEntryStatusEnumValue provides a map of StatementEntryStatuss for fast verification of use input
+var validStatementEntryStatusEnumValues = map[StatementEntryStatus]struct{}{
+	"matched":   {},
+	"unmatched": {},
+	"resolved":  {},
+	"dismissed": {},
+}
+
+// IsValid return true if the value is valid for the enum, false otherwise
+func (v StatementEntryStatus) IsValid() bool {
+	_, ok := validStatementEntryStatusEnumValues[v]
+	return ok
+}
+
+// NewStatementEntryStatusFromValue returns a pointer to a valid StatementEntryStatus
+// for the value passed as argument, or an error if the value passed is not allowed by the enum
+func NewStatementEntryStatusFromValue(v string) (StatementEntryStatus, error) {
+	ev := StatementEntryStatus(v)
+	if ev.IsValid() {
+		return ev, nil
+	}
+
+	return "", fmt.Errorf("invalid value '%v' for StatementEntryStatus: valid values are %v", v, AllowedStatementEntryStatusEnumValues)
+}
+
+
+
+// AssertStatementEntryStatusRequired checks if the required fields are not zero-ed
+func AssertStatementEntryStatusRequired(obj StatementEntryStatus) error {
+	return nil
+}
+
+// AssertStatementEntryStatusConstraints checks if the values respects the defined constraints
+func AssertStatementEntryStatusConstraints(obj StatementEntryStatus) error {
+	return nil
+}
@@ -0,0 +1,207 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// directDebit is a single direct debit of a billing run
+type directDebit struct {
+	installment InstallmentRes
+	mandate     MandateRes
+	remittance  string
+}
+
+// pain008Document is an ISO 20022 customer direct debit initiation in version 08. The pain008 types mirror
+// the parts of the schema used for SEPA Core direct debits.
+type pain008Document struct {
+	XMLName    xml.Name          `xml:"urn:iso:std:iso:20022:tech:xsd:pain.008.001.08 Document"`
+	Initiation pain008Initiation `xml:"CstmrDrctDbtInitn"`
+}
+
+type pain008Initiation struct {
+	GroupHeader        pain008GroupHeader          `xml:"GrpHdr"`
+	PaymentInformation []pain008PaymentInformation `xml:"PmtInf"`
+}
+
+type pain008GroupHeader struct {
+	MessageId            string      `xml:"MsgId"`
+	CreationDateTime     string      `xml:"CreDtTm"`
+	NumberOfTransactions int         `xml:"NbOfTxs"`
+	ControlSum           sepaAmount  `xml:"CtrlSum"`
+	InitiatingParty      pain008Name `xml:"InitgPty"`
+}
+
+type pain008PaymentInformation struct {
+	PaymentInformationId string                  `xml:"PmtInfId"`
+	PaymentMethod        string                  `xml:"PmtMtd"`
+	BatchBooking         bool                    `xml:"BtchBookg"`
+	NumberOfTransactions int                     `xml:"NbOfTxs"`
+	ControlSum           sepaAmount              `xml:"CtrlSum"`
+	ServiceLevel         string                  `xml:"PmtTpInf>SvcLvl>Cd"`
+	LocalInstrument      string                  `xml:"PmtTpInf>LclInstrm>Cd"`
+	SequenceType         MandateSequenceType     `xml:"PmtTpInf>SeqTp"`
+	CollectionDate       string                  `xml:"ReqdColltnDt"`
+	Creditor             pain008Name             `xml:"Cdtr"`
+	CreditorIban         string                  `xml:"CdtrAcct>Id>IBAN"`
+	CreditorAgent        pain008Agent            `xml:"CdtrAgt"`
+	ChargeBearer         string                  `xml:"ChrgBr"`
+	CreditorSchemeId     string                  `xml:"CdtrSchmeId>Id>PrvtId>Othr>Id"`
+	CreditorSchemeName   string                  `xml:"CdtrSchmeId>Id>PrvtId>Othr>SchmeNm>Prtry"`
+	Transactions         []pain008DirectDebitTxn `xml:"DrctDbtTxInf"`
+}
+
+type pain008DirectDebitTxn struct {
+	EndToEndId       string        `xml:"PmtId>EndToEndId"`
+	InstructedAmount pain008Amount `xml:"InstdAmt"`
+	MandateId        string        `xml:"DrctDbtTx>MndtRltdInf>MndtId"`
+	SignatureDate    string        `xml:"DrctDbtTx>MndtRltdInf>DtOfSgntr"`
+	DebtorAgent      pain008Agent  `xml:"DbtrAgt"`
+	Debtor           pain008Name   `xml:"Dbtr"`
+	DebtorIban       string        `xml:"DbtrAcct>Id>IBAN"`
+	Remittance       string        `xml:"RmtInf>Ustrd"`
+}
+
+type pain008Name struct {
+	Name string `xml:"Nm"`
+}
+
+// pain008Agent identifies a bank by its BIC, or as NOTPROVIDED if the BIC is unknown
+type pain008Agent struct {
+	Bic   string          `xml:"FinInstnId>BICFI,omitempty"`
+	Other *pain008OtherId `xml:"FinInstnId>Othr,omitempty"`
+}
+
+type pain008OtherId struct {
+	Id string `xml:"Id"`
+}
+
+type pain008Amount struct {
+	Currency string     `xml:"Ccy,attr"`
+	Amount   sepaAmount `xml:",chardata"`
+}
+
+// sepaAmount is an amount in cents written as euros with two decimals
+type sepaAmount int64
+
+// MarshalText implements encoding.TextMarshaler
+func (a sepaAmount) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%d.%02d", a/100, a%100)), nil
+}
+
+// newPain008 creates the pain.008 file of a billing run, with one payment information block per batch.
+// The debits of a batch are looked up by installment id.
+func newPain008(run BillingRunRes, creditor Creditor, debits map[string]directDebit, created time.Time) ([]byte, error) {
+	document := pain008Document{
+		Initiation: pain008Initiation{
+			GroupHeader: pain008GroupHeader{
+				MessageId:            run.MessageId,
+				CreationDateTime:     created.UTC().Format("2006-01-02T15:04:05"),
+				NumberOfTransactions: int(run.NumberOfTransactions),
+				ControlSum:           sepaAmount(toCents(run.ControlSum)),
+				InitiatingParty:      pain008Name{Name: sepaText(creditor.Name, 70)},
+			},
+		},
+	}
+
+	for _, batch := range run.Batches {
+		information := pain008PaymentInformation{
+			PaymentInformationId: batch.PaymentInformationId,
+			PaymentMethod:        "DD",
+			BatchBooking:         true,
+			NumberOfTransactions: int(batch.NumberOfTransactions),
+			ControlSum:           sepaAmount(toCents(batch.ControlSum)),
+			ServiceLevel:         "SEPA",
+			LocalInstrument:      "CORE",
+			SequenceType:         batch.SequenceType,
+			CollectionDate:       batch.CollectionDate,
+			Creditor:             pain008Name{Name: sepaText(creditor.Name, 70)},
+			CreditorIban:         creditor.Iban,
+			CreditorAgent:        newPain008Agent(creditor.Bic),
+			ChargeBearer:         "SLEV",
+			CreditorSchemeId:     creditor.Id,
+			CreditorSchemeName:   "SEPA",
+		}
+		for _, installmentId := range batch.InstallmentIds {
+			debit, ok := debits[installmentId]
+			if !ok {
+				return nil, fmt.Errorf("installment %s of batch %s has no direct debit", installmentId, batch.PaymentInformationId)
+			}
+			information.Transactions = append(information.Transactions, pain008DirectDebitTxn{
+				EndToEndId:       debit.installment.EndToEndId,
+				InstructedAmount: pain008Amount{Currency: "EUR", Amount: sepaAmount(toCents(debit.installment.Amount))},
+				MandateId:        debit.mandate.Reference,
+				SignatureDate:    debit.mandate.SignatureDate,
+				DebtorAgent:      newPain008Agent(debit.mandate.Bic),
+				Debtor:           pain008Name{Name: sepaText(debit.mandate.AccountHolder, 70)},
+				DebtorIban:       debit.mandate.Iban,
+				Remittance:       sepaText(debit.remittance, 140),
+			})
+		}
+		document.Initiation.PaymentInformation = append(document.Initiation.PaymentInformation, information)
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buffer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+	buffer.WriteString("\n")
+
+	return buffer.Bytes(), nil
+}
+
+// newPain008Agent identifies the bank with the BIC, which is optional in SEPA payments within the EEA
+func newPain008Agent(bic string) pain008Agent {
+	if bic == "" {
+		return pain008Agent{Other: &pain008OtherId{Id: "NOTPROVIDED"}}
+	}
+
+	return pain008Agent{Bic: bic}
+}
+
+// sepaTransliterations replaces the German characters outside of the SEPA character set
+var sepaTransliterations = strings.NewReplacer("Ä", "Ae", "Ö", "Oe", "Ü", "Ue", "ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss", "&", "+")
+
+// sepaText restricts text to the Latin character set that every bank accepts in SEPA payments and to at most
+// max characters. Other characters are replaced by a space.
+func sepaText(text string, max int) string {
+	text = sepaTransliterations.Replace(text)
+
+	var builder strings.Builder
+	for _, c := range text {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', strings.ContainsRune("/-?:().,'+ ", c):
+			builder.WriteRune(c)
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+
+	result := strings.TrimSpace(builder.String())
+	if len(result) > max {
+		result = strings.TrimSpace(result[:max])
+	}
+
+	return result
+}
+
+// paymentInformationId returns the PmtInfId of the batch with the given index of a message
+func paymentInformationId(messageId string, index int) string {
+	return messageId + "-" + strconv.Itoa(index+1)
+}
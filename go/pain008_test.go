@@ -0,0 +1,104 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCreditor is the creditor of the pain.008 files created by the tests
+var testCreditor = Creditor{
+	Id:   "DE98ZZZ09999999999",
+	Name: "Katzen & Co. Versicherung AG",
+	Iban: "DE89370400440532013000",
+	Bic:  "COBADEFFXXX",
+}
+
+// testBillingRun collects a first debit of 12.50 € and a recurrent one of 7.05 € in two batches
+var testBillingRun = BillingRunRes{
+	MessageId:            "CI-20261016-1",
+	NumberOfTransactions: 2,
+	ControlSum:           19.55,
+	Batches: []BillingBatch{
+		{PaymentInformationId: "CI-20261016-1-1", CollectionDate: "2026-10-21", SequenceType: MandateSequenceTypeFrst, NumberOfTransactions: 1, ControlSum: 12.5, InstallmentIds: []string{"i1"}},
+		{PaymentInformationId: "CI-20261016-1-2", CollectionDate: "2026-10-19", SequenceType: MandateSequenceTypeRcur, NumberOfTransactions: 1, ControlSum: 7.05, InstallmentIds: []string{"i2"}},
+	},
+}
+
+func TestNewPain008(t *testing.T) {
+	debits := map[string]directDebit{
+		"i1": {
+			installment: InstallmentRes{Id: "i1", Amount: 12.5, EndToEndId: "E2E-1"},
+			mandate:     MandateRes{Reference: "CI-20260901-A", Iban: "DE02120300000000202051", Bic: "BYLADEM1001", AccountHolder: "Jürgen Weiß", SignatureDate: "2026-09-01"},
+			remittance:  "Beitrag Vertrag 4711 Oktober 2026",
+		},
+		"i2": {
+			installment: InstallmentRes{Id: "i2", Amount: 7.05, EndToEndId: "E2E-2"},
+			mandate:     MandateRes{Reference: "CI-20250101-B", Iban: "AT611904300234573201", AccountHolder: "Max Mustermann", SignatureDate: "2025-01-01"},
+			remittance:  "Beitrag Vertrag 4712 Oktober 2026",
+		},
+	}
+	created := time.Date(2026, 10, 16, 9, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	tests := []struct {
+		name   string
+		debits map[string]directDebit
+		// golden is the file in testdata holding the expected document
+		golden  string
+		wantErr bool
+	}{
+		{name: "first and recurrent debits", debits: debits, golden: "billing_run.pain008.xml"},
+		{name: "installment without debit", debits: map[string]directDebit{"i1": debits["i1"]}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, err := newPain008(testBillingRun, testCreditor, tt.debits, created)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newPain008() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want, err := os.ReadFile(filepath.Join("testdata", tt.golden))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(document) != string(want) {
+				t.Errorf("newPain008() =\n%s\nwant\n%s", document, want)
+			}
+		})
+	}
+}
+
+func TestSepaText(t *testing.T) {
+	tests := []struct {
+		text string
+		max  int
+		want string
+	}{
+		{text: "Max Mustermann", max: 70, want: "Max Mustermann"},
+		{text: "Jürgen Weiß", max: 70, want: "Juergen Weiss"},
+		{text: "ÄÖÜ äöü", max: 70, want: "AeOeUe aeoeue"},
+		{text: "Katzen & Co.", max: 70, want: "Katzen + Co."},
+		{text: "Beitrag: 10/2026 (Kätzchen) – 5 €", max: 140, want: "Beitrag: 10/2026 (Kaetzchen)   5"},
+		{text: "  Mia\n", max: 70, want: "Mia"},
+		{text: "Beitrag Oktober", max: 8, want: "Beitrag"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := sepaText(tt.text, tt.max); got != tt.want {
+				t.Errorf("sepaText(%q, %d) = %q, want %q", tt.text, tt.max, got, tt.want)
+			}
+		})
+	}
+}
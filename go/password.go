@@ -0,0 +1,99 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2idParams are the cost parameters of new password hashes, the minimum recommended by OWASP:
+// 19 MiB of memory, 2 iterations and 1 degree of parallelism
+var argon2idParams = struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+	keyLen  uint32
+	saltLen int
+}{memory: 19 * 1024, time: 2, threads: 1, keyLen: 32, saltLen: 16}
+
+// dummyPasswordHash is the hash of a random password that is verified when logging in with an unknown email
+// address, so that the response time does not tell whether an account exists
+const dummyPasswordHash = "$argon2id$v=19$m=19456,t=2,p=1$HMyzO6UY3QNIcB19lREj5w$OhiwI1IOBxvzH9tuV+vBrjvIt5Z2c/ldTbKI/faEFkY"
+
+// hashPassword hashes a password with argon2id and a random salt. The hash is encoded in the PHC string
+// format together with its parameters, e.g. $argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>, so that hashes
+// created with other parameters can still be verified.
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, argon2idParams.saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, argon2idParams.time, argon2idParams.memory, argon2idParams.threads, argon2idParams.keyLen)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, argon2idParams.memory, argon2idParams.time,
+		argon2idParams.threads, base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// verifyPassword reports whether the password matches a hash created by hashPassword
+func verifyPassword(password string, encoded string) (bool, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return false, errors.New("not an argon2id password hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, fmt.Errorf("unsupported argon2 version %q", parts[2])
+	}
+	var memory, time uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, fmt.Errorf("invalid argon2 parameters %q", parts[3])
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, errors.New("invalid argon2 salt")
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return false, errors.New("invalid argon2 hash")
+	}
+
+	candidate := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(candidate, key) == 1, nil
+}
+
+// newSecretToken creates a random single-use token, e.g. for verifying an email address, and the hash it is
+// stored as. Only the hash is stored, so the stored tokens cannot be used if the database leaks.
+func newSecretToken() (token string, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+
+	return token, secretTokenHash(token), nil
+}
+
+// secretTokenHash returns the hash a token created by newSecretToken is stored as
+func secretTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,286 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// dateLayout is the layout of all format: date fields of the api
+const dateLayout = "2006-01-02"
+
+// RateFactor is a single multiplicative factor applied by the RateEngine
+type RateFactor struct {
+	Name   string  `json:"name"`
+	Value  string  `json:"value"`
+	Factor float64 `json:"factor"`
+}
+
+// Bounds of the tariff that may be applied to a premium
+const (
+	RateCapMinimum = "minimum"
+	RateCapMaximum = "maximum"
+)
+
+// RateCalculation is the result of a premium calculation
+type RateCalculation struct {
+	// BaseRate and CoveragePremium are the additive parts of the BasePremium
+	BaseRate        float64
+	CoveragePremium float64
+	// BasePremium is the yearly premium derived from the coverage before any factor is applied
+	BasePremium float64
+	Factors     []RateFactor
+	// UncappedRate is the premium after all factors, before the tariff bounds were applied
+	UncappedRate float64
+	// MinimumRate and MaximumRate are the bounds of the tariff; Cap names the one applied, if any
+	MinimumRate float64
+	MaximumRate float64
+	Cap         string
+	// Rate is the final yearly premium after factors and bounds were applied
+	Rate float64
+	// TariffVersion is the version of the tariff the premium was calculated with
+	TariffVersion string
+	// Installments split the rate into installments for every payment frequency
+	Installments []InstallmentPlan
+}
+
+// RateEngine derives yearly premiums from versioned Tariffs. New premiums are calculated with the
+// current tariff, i.e. the one with the latest validFrom date that is not in the future.
+type RateEngine struct {
+	source TariffSource
+	now    func() time.Time
+
+	mu      sync.RWMutex
+	tariffs map[string]*Tariff
+	// ordered holds the tariffs sorted by validFrom
+	ordered []*Tariff
+}
+
+// NewRateEngine creates a rate engine with the tariffs loaded from source
+func NewRateEngine(source TariffSource) (*RateEngine, error) {
+	e := &RateEngine{
+		source: source,
+		now:    time.Now,
+	}
+	if err := e.Reload(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Reload loads the tariffs from the source again. On error the previously loaded tariffs are kept.
+func (e *RateEngine) Reload() error {
+	loaded, err := e.source()
+	if err != nil {
+		return err
+	}
+	if len(loaded) == 0 {
+		return errors.New("no tariff found")
+	}
+
+	tariffs := make(map[string]*Tariff, len(loaded))
+	for _, tariff := range loaded {
+		if _, ok := tariffs[tariff.Version]; ok {
+			return fmt.Errorf("tariff version %s is defined twice", tariff.Version)
+		}
+		tariffs[tariff.Version] = tariff
+	}
+	ordered := append([]*Tariff{}, loaded...)
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].ValidFrom != ordered[j].ValidFrom {
+			return ordered[i].ValidFrom < ordered[j].ValidFrom
+		}
+		return ordered[i].Version < ordered[j].Version
+	})
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.tariffs = tariffs
+	e.ordered = ordered
+
+	return nil
+}
+
+// Versions returns the versions of all loaded tariffs ordered by validFrom
+func (e *RateEngine) Versions() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	versions := make([]string, len(e.ordered))
+	for i, tariff := range e.ordered {
+		versions[i] = tariff.Version
+	}
+
+	return versions
+}
+
+// current returns the tariff valid on day. If every tariff lies in the future the earliest one is used.
+func (e *RateEngine) current(day time.Time) *Tariff {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	date := day.Format(dateLayout)
+	current := e.ordered[0]
+	for _, tariff := range e.ordered {
+		if tariff.ValidFrom <= date {
+			current = tariff
+		}
+	}
+
+	return current
+}
+
+// Calculate derives the premium for a cat described by the request using the current tariff
+func (e *RateEngine) Calculate(req RateCalculationReq) (RateCalculation, error) {
+	return e.CalculateOn(req, e.now())
+}
+
+// CalculateOn derives the premium as of day, i.e. with the tariff valid on day and the cat's age on day
+func (e *RateEngine) CalculateOn(req RateCalculationReq, day time.Time) (RateCalculation, error) {
+	return e.calculate(e.current(day), req, day)
+}
+
+// Tariff returns the loaded tariff with the given version
+func (e *RateEngine) Tariff(version string) (*Tariff, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	tariff, ok := e.tariffs[version]
+	if !ok {
+		return nil, fmt.Errorf("tariff version %s is not available", version)
+	}
+
+	return tariff, nil
+}
+
+// CalculateVersion derives the premium as of day using the given tariff version
+func (e *RateEngine) CalculateVersion(req RateCalculationReq, version string, day time.Time) (RateCalculation, error) {
+	tariff, err := e.Tariff(version)
+	if err != nil {
+		return RateCalculation{}, err
+	}
+
+	return e.calculate(tariff, req, day)
+}
+
+func (e *RateEngine) calculate(t *Tariff, req RateCalculationReq, day time.Time) (RateCalculation, error) {
+	birthDate, err := time.Parse(dateLayout, req.BirthDate)
+	if err != nil {
+		return RateCalculation{}, fmt.Errorf("birthDate %q is not a valid date", req.BirthDate)
+	}
+	age, err := ageInYears(birthDate, day)
+	if err != nil {
+		return RateCalculation{}, err
+	}
+
+	neutered := t.IntactFactor
+	if req.Neutered {
+		neutered = t.NeuteredFactor
+	}
+	zipCode := int(req.ZipCode)
+	region, regionFactor := t.region(zipCode)
+
+	coveragePremium := float64(req.Coverage) * t.CoverageRate
+	calculation := RateCalculation{
+		TariffVersion:   t.Version,
+		BaseRate:        t.BaseRate,
+		CoveragePremium: coveragePremium,
+		BasePremium:     t.BaseRate + coveragePremium,
+		MinimumRate:     t.MinimumRate,
+		MaximumRate:     t.MaximumRate,
+		Factors: []RateFactor{
+			{Name: "age", Value: strconv.Itoa(age), Factor: t.ageFactor(age)},
+			{Name: "breed", Value: req.Breed, Factor: t.Breeds.lookup(req.Breed)},
+			{Name: "color", Value: req.Color, Factor: t.Colors.lookup(req.Color)},
+			{Name: "weight", Value: strconv.FormatFloat(float64(req.Weight), 'f', -1, 32), Factor: t.weightFactor(float64(req.Weight))},
+			{Name: "neutered", Value: strconv.FormatBool(req.Neutered), Factor: neutered},
+			{Name: "environment", Value: req.Environment, Factor: t.Environments.lookup(req.Environment)},
+			{Name: "personality", Value: req.Personality, Factor: t.Personalities.lookup(req.Personality)},
+			{Name: "region", Value: strings.TrimSpace(fmt.Sprintf("%05d %s", zipCode, region)), Factor: regionFactor},
+		},
+	}
+
+	rate := calculation.BasePremium
+	for _, factor := range calculation.Factors {
+		rate *= factor.Factor
+	}
+	calculation.UncappedRate = math.Round(rate*100) / 100
+	switch {
+	case rate < t.MinimumRate:
+		rate = t.MinimumRate
+		calculation.Cap = RateCapMinimum
+	case rate > t.MaximumRate:
+		rate = t.MaximumRate
+		calculation.Cap = RateCapMaximum
+	}
+	calculation.Rate = math.Round(rate*100) / 100
+	calculation.Installments = installmentPlans(float32(calculation.Rate), t)
+
+	if err := AssertRateResConstraints(RateRes{Rate: float32(calculation.Rate)}); err != nil {
+		return RateCalculation{}, fmt.Errorf("calculated rate %v is out of bounds: %w", calculation.Rate, err)
+	}
+
+	return calculation, nil
+}
+
+// ageInYears returns the number of completed years between birthDate and now
+func ageInYears(birthDate time.Time, now time.Time) (int, error) {
+	if birthDate.After(now) {
+		return 0, fmt.Errorf("birthDate %s lies in the future", birthDate.Format(dateLayout))
+	}
+
+	age := now.Year() - birthDate.Year()
+	if now.Month() < birthDate.Month() || (now.Month() == birthDate.Month() && now.Day() < birthDate.Day()) {
+		age--
+	}
+
+	return age, nil
+}
+
+// ageFactor returns the factor of the first age band the cat is younger than
+func (t *Tariff) ageFactor(age int) float64 {
+	for _, band := range t.Ages {
+		if band.MaxAge == 0 || age < band.MaxAge {
+			return band.Factor
+		}
+	}
+
+	return t.Ages[len(t.Ages)-1].Factor
+}
+
+// weightFactor returns the factor of the first weight band the cat is lighter than
+func (t *Tariff) weightFactor(weight float64) float64 {
+	for _, band := range t.Weights {
+		if band.MaxWeight == 0 || weight < band.MaxWeight {
+			return band.Factor
+		}
+	}
+
+	return t.Weights[len(t.Weights)-1].Factor
+}
+
+// region returns the name and factor of the region containing zipCode
+func (t *Tariff) region(zipCode int) (string, float64) {
+	for _, region := range t.Regions {
+		if zipCode >= region.From && zipCode <= region.To {
+			return region.Name, region.Factor
+		}
+	}
+
+	return "", 1
+}
@@ -0,0 +1,173 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"testing"
+	"time"
+)
+
+// testRateEngine returns a rate engine with the default tariff 2024-04 and a tariff 2026-07 valid from
+// 2026-07-01 with a higher base rate and tighter bounds
+func testRateEngine(t *testing.T, now time.Time) *RateEngine {
+	t.Helper()
+	engine, err := NewRateEngine(func() ([]*Tariff, error) {
+		current, err := ParseTariff(defaultTariffJSON)
+		if err != nil {
+			return nil, err
+		}
+		next, err := ParseTariff(defaultTariffJSON)
+		if err != nil {
+			return nil, err
+		}
+		next.Version = "2026-07"
+		next.ValidFrom = "2026-07-01"
+		next.BaseRate = 80
+		next.MinimumRate = 100
+		next.MaximumRate = 500
+
+		return []*Tariff{next, current}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine.now = func() time.Time { return now }
+
+	return engine
+}
+
+// testRateRequest describes a neutered six year old Bengal living indoors in Berlin
+func testRateRequest() RateCalculationReq {
+	return RateCalculationReq{
+		Coverage:    5000,
+		Breed:       "Bengal",
+		Color:       "Schwarz",
+		BirthDate:   "2020-03-01",
+		Neutered:    true,
+		Personality: "Ruhig",
+		Environment: "Wohnung",
+		Weight:      4000,
+		ZipCode:     10115,
+	}
+}
+
+func TestRateEngineCalculate(t *testing.T) {
+	tests := []struct {
+		name    string
+		now     string
+		change  func(req *RateCalculationReq)
+		want    float64
+		version string
+		wantErr bool
+	}{
+		{name: "default tariff", now: "2026-03-01", want: 76.89, version: "2024-04"},
+		{name: "lookup ignores case", now: "2026-03-01", change: func(req *RateCalculationReq) { req.Breed = "bENGAL" }, want: 76.89, version: "2024-04"},
+		{name: "unknown breed uses the default factor", now: "2026-03-01", change: func(req *RateCalculationReq) { req.Breed = "Kartaeuser" }, want: 66.86, version: "2024-04"},
+		{name: "kitten", now: "2026-03-01", change: func(req *RateCalculationReq) { req.BirthDate = "2026-02-28" }, want: 84.58, version: "2024-04"},
+		{name: "eighth birthday", now: "2026-03-01", change: func(req *RateCalculationReq) { req.BirthDate = "2018-03-01" }, want: 99.96, version: "2024-04"},
+		{name: "day before the eighth birthday", now: "2026-03-01", change: func(req *RateCalculationReq) { req.BirthDate = "2018-03-02" }, want: 76.89, version: "2024-04"},
+		{
+			name: "every factor above one",
+			now:  "2026-03-01",
+			change: func(req *RateCalculationReq) {
+				*req = RateCalculationReq{Coverage: 10000, Breed: "Mainecoon", Color: "Weiss", BirthDate: "2010-01-01", Personality: "Wild", Environment: "Stadt", Weight: 9000, ZipCode: 80331}
+			},
+			want:    518.02,
+			version: "2024-04",
+		},
+		{name: "tariff valid from today", now: "2026-07-01", want: 100, version: "2026-07"},
+		{name: "birth date in the future", now: "2026-03-01", change: func(req *RateCalculationReq) { req.BirthDate = "2026-03-02" }, wantErr: true},
+		{name: "invalid birth date", now: "2026-03-01", change: func(req *RateCalculationReq) { req.BirthDate = "01.03.2020" }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now, _ := time.Parse(dateLayout, tt.now)
+			req := testRateRequest()
+			if tt.change != nil {
+				tt.change(&req)
+			}
+
+			calculation, err := testRateEngine(t, now).Calculate(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Calculate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if calculation.Rate != tt.want || calculation.TariffVersion != tt.version {
+				t.Errorf("Calculate() = %v with tariff %s, want %v with tariff %s", calculation.Rate, calculation.TariffVersion, tt.want, tt.version)
+			}
+			if len(calculation.Installments) != len(AllowedPaymentFrequencyEnumValues) {
+				t.Errorf("Calculate() returned %d installment plans, want %d", len(calculation.Installments), len(AllowedPaymentFrequencyEnumValues))
+			}
+		})
+	}
+}
+
+func TestRateEngineCalculateVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      string
+		day          string
+		change       func(req *RateCalculationReq)
+		want         float64
+		wantUncapped float64
+		wantCap      string
+		wantErr      bool
+		wantBaseRate float64
+		wantCoverage float64
+	}{
+		{name: "earlier tariff after a newer one is valid", version: "2024-04", day: "2026-08-01", want: 76.89, wantUncapped: 76.89, wantBaseRate: 60, wantCoverage: 20},
+		{name: "minimum rate", version: "2026-07", day: "2026-08-01", want: 100, wantUncapped: 96.11, wantCap: RateCapMinimum, wantBaseRate: 80, wantCoverage: 20},
+		{
+			name:    "maximum rate",
+			version: "2026-07",
+			day:     "2026-08-01",
+			change: func(req *RateCalculationReq) {
+				*req = RateCalculationReq{Coverage: 10000, Breed: "Mainecoon", Color: "Weiss", BirthDate: "2010-01-01", Personality: "Wild", Environment: "Stadt", Weight: 9000, ZipCode: 80331}
+			},
+			want:         500,
+			wantUncapped: 621.62,
+			wantCap:      RateCapMaximum,
+			wantBaseRate: 80,
+			wantCoverage: 40,
+		},
+		{name: "tariff planned for later", version: "2026-07", day: "2026-01-01", want: 100, wantUncapped: 96.11, wantCap: RateCapMinimum, wantBaseRate: 80, wantCoverage: 20},
+		{name: "unknown version", version: "2025-01", day: "2026-08-01", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day, _ := time.Parse(dateLayout, tt.day)
+			req := testRateRequest()
+			if tt.change != nil {
+				tt.change(&req)
+			}
+
+			calculation, err := testRateEngine(t, day).CalculateVersion(req, tt.version, day)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CalculateVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if calculation.Rate != tt.want || calculation.UncappedRate != tt.wantUncapped || calculation.Cap != tt.wantCap {
+				t.Errorf("CalculateVersion() = %v (uncapped %v, cap %q), want %v (uncapped %v, cap %q)",
+					calculation.Rate, calculation.UncappedRate, calculation.Cap, tt.want, tt.wantUncapped, tt.wantCap)
+			}
+			if calculation.BaseRate != tt.wantBaseRate || calculation.CoveragePremium != tt.wantCoverage {
+				t.Errorf("CalculateVersion() base rate %v and coverage premium %v, want %v and %v",
+					calculation.BaseRate, calculation.CoveragePremium, tt.wantBaseRate, tt.wantCoverage)
+			}
+			if calculation.TariffVersion != tt.version {
+				t.Errorf("CalculateVersion() used tariff %s, want %s", calculation.TariffVersion, tt.version)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"fmt"
+	"sort"
+)
+
+// payInstallment marks an installment as paid on the booking date, or returns an error wrapping ErrInvalidTransition.
+// Returned installments may still be paid, e.g. by a transfer of the customer.
+func payInstallment(installment *InstallmentRes, bookingDate string) error {
+	switch installment.Status {
+	case InstallmentStatusOpen, InstallmentStatusSubmitted, InstallmentStatusReturned:
+	default:
+		return fmt.Errorf("%w: installment %s is %s and cannot be paid", ErrInvalidTransition, installment.Id, installment.Status)
+	}
+
+	installment.Status = InstallmentStatusPaid
+	installment.PaymentDate = bookingDate
+
+	return nil
+}
+
+// returnInstallment marks the direct debit of an installment as returned, or returns an error wrapping
+// ErrInvalidTransition. Paid direct debits can be returned too, e.g. on request of the debtor within 8 weeks.
+func returnInstallment(installment *InstallmentRes, bookingDate string, reason string) error {
+	switch installment.Status {
+	case InstallmentStatusSubmitted, InstallmentStatusPaid:
+	default:
+		return fmt.Errorf("%w: installment %s is %s and cannot be returned", ErrInvalidTransition, installment.Id, installment.Status)
+	}
+
+	installment.Status = InstallmentStatusReturned
+	installment.ReturnDate = bookingDate
+	installment.ReturnReason = reason
+
+	return nil
+}
+
+// reconciler matches the entries of bank statements to the installments collected by direct debit
+type reconciler struct {
+	installments []*InstallmentRes
+	byEndToEndId map[string]*InstallmentRes
+	batches      map[string]BillingBatch
+	// changed holds the ids of the installments paid or returned by matched entries
+	changed map[string]bool
+}
+
+// newReconciler creates a reconciler for the installments and the batches of the billing runs. Matching entries
+// changes the installments in place.
+func newReconciler(installments []InstallmentRes, runs []BillingRunRes) *reconciler {
+	r := &reconciler{
+		byEndToEndId: make(map[string]*InstallmentRes),
+		batches:      make(map[string]BillingBatch),
+		changed:      make(map[string]bool),
+	}
+	for i := range installments {
+		installment := &installments[i]
+		r.installments = append(r.installments, installment)
+		if installment.EndToEndId != "" {
+			r.byEndToEndId[installment.EndToEndId] = installment
+		}
+	}
+	for _, run := range runs {
+		for _, batch := range run.Batches {
+			r.batches[batch.PaymentInformationId] = batch
+		}
+	}
+
+	return r
+}
+
+// match pays or returns the installments of an entry and returns their ids, or the reason the entry could not be
+// matched. Credits are matched by end-to-end id, by the batch they were booked in or by mandate reference and
+// amount; debits, the returns of direct debits, by end-to-end id or by mandate reference and amount.
+func (r *reconciler) match(entry StatementEntryRes) ([]string, string) {
+	if installment, ok := r.byEndToEndId[entry.EndToEndId]; ok && entry.EndToEndId != "" {
+		if toCents(installment.Amount) != toCents(entry.Amount) {
+			return nil, fmt.Sprintf("the amount differs from the %.2f of installment %s", installment.Amount, installment.Id)
+		}
+		return r.apply(entry, installment)
+	}
+
+	if batch, ok := r.batches[entry.PaymentInformationId]; ok && entry.CreditDebit == CreditDebitIndicatorCrdt {
+		if toCents(batch.ControlSum) != toCents(entry.Amount) {
+			return nil, fmt.Sprintf("the amount differs from the %.2f of batch %s", batch.ControlSum, batch.PaymentInformationId)
+		}
+		ids := make([]string, 0)
+		for _, installment := range r.installments {
+			if contains(batch.InstallmentIds, installment.Id) && installment.Status == InstallmentStatusSubmitted {
+				r.apply(entry, installment)
+				ids = append(ids, installment.Id)
+			}
+		}
+		if len(ids) == 0 {
+			return nil, fmt.Sprintf("no installment of batch %s is awaiting payment", batch.PaymentInformationId)
+		}
+		return ids, ""
+	}
+
+	if entry.MandateReference != "" {
+		if installment := r.byMandate(entry); installment != nil {
+			return r.apply(entry, installment)
+		}
+	}
+
+	return nil, "no installment matches the end-to-end id, batch or mandate reference"
+}
+
+// apply pays or returns a single installment
+func (r *reconciler) apply(entry StatementEntryRes, installment *InstallmentRes) ([]string, string) {
+	var err error
+	if entry.CreditDebit == CreditDebitIndicatorCrdt {
+		if installment.Status != InstallmentStatusSubmitted {
+			return nil, fmt.Sprintf("installment %s is %s, not awaiting payment", installment.Id, installment.Status)
+		}
+		err = payInstallment(installment, entry.BookingDate)
+	} else {
+		err = returnInstallment(installment, entry.BookingDate, entry.ReturnReason)
+	}
+	if err != nil {
+		return nil, err.Error()
+	}
+	r.changed[installment.Id] = true
+
+	return []string{installment.Id}, ""
+}
+
+// byMandate finds the installment of a credit or return quoting a mandate reference but no known end-to-end id:
+// the oldest submitted installment with the amount for a credit, the latest collected one for a return
+func (r *reconciler) byMandate(entry StatementEntryRes) *InstallmentRes {
+	candidates := make([]*InstallmentRes, 0)
+	for _, installment := range r.installments {
+		if installment.MandateReference != entry.MandateReference || toCents(installment.Amount) != toCents(entry.Amount) {
+			continue
+		}
+		switch {
+		case entry.CreditDebit == CreditDebitIndicatorCrdt && installment.Status == InstallmentStatusSubmitted,
+			entry.CreditDebit == CreditDebitIndicatorDbit && (installment.Status == InstallmentStatusSubmitted || installment.Status == InstallmentStatusPaid):
+			candidates = append(candidates, installment)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].CollectionDate < candidates[j].CollectionDate
+	})
+	if entry.CreditDebit == CreditDebitIndicatorCrdt {
+		return candidates[0]
+	}
+
+	return candidates[len(candidates)-1]
+}
+
+// contains reports whether the ids contain id
+func contains(ids []string, id string) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testInstallments returns installments collected with the mandates M1 and M2 in the batches B1 and B2, and an open
+// one of mandate M3
+func testInstallments() ([]InstallmentRes, []BillingRunRes) {
+	installments := []InstallmentRes{
+		{Id: "a", Amount: 10, Status: InstallmentStatusSubmitted, EndToEndId: "E2E-A", MandateReference: "M1", CollectionDate: "2026-10-05"},
+		{Id: "b", Amount: 20, Status: InstallmentStatusSubmitted, EndToEndId: "E2E-B", MandateReference: "M2", CollectionDate: "2026-10-05"},
+		{Id: "c", Amount: 10, Status: InstallmentStatusPaid, EndToEndId: "E2E-C", MandateReference: "M1", CollectionDate: "2026-09-07"},
+		{Id: "d", Amount: 10, Status: InstallmentStatusSubmitted, EndToEndId: "E2E-D", MandateReference: "M1", CollectionDate: "2026-11-05"},
+		{Id: "e", Amount: 15, Status: InstallmentStatusOpen, MandateReference: "M3"},
+	}
+	runs := []BillingRunRes{
+		{Batches: []BillingBatch{{PaymentInformationId: "B1", ControlSum: 30, InstallmentIds: []string{"a", "b"}}}},
+		{Batches: []BillingBatch{{PaymentInformationId: "B2", ControlSum: 10, InstallmentIds: []string{"d"}}}},
+	}
+
+	return installments, runs
+}
+
+func TestReconcilerMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		entry      StatementEntryRes
+		wantIds    []string
+		wantReason string
+		// wantStatus holds the status of every installment changed by the entry
+		wantStatus map[string]InstallmentStatus
+	}{
+		{
+			name:       "credit by end-to-end id",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorCrdt, Amount: 10, EndToEndId: "E2E-A"},
+			wantIds:    []string{"a"},
+			wantStatus: map[string]InstallmentStatus{"a": InstallmentStatusPaid},
+		},
+		{
+			name:       "credit by end-to-end id with another amount",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorCrdt, Amount: 11, EndToEndId: "E2E-A", MandateReference: "M1"},
+			wantReason: "the amount differs from the 10.00 of installment a",
+		},
+		{
+			name:       "credit of a paid installment",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorCrdt, Amount: 10, EndToEndId: "E2E-C"},
+			wantReason: "installment c is paid, not awaiting payment",
+		},
+		{
+			name:       "return of a paid installment",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorDbit, Amount: 10, EndToEndId: "E2E-C", ReturnReason: "MD06"},
+			wantIds:    []string{"c"},
+			wantStatus: map[string]InstallmentStatus{"c": InstallmentStatusReturned},
+		},
+		{
+			name:       "credit of a batch",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorCrdt, Amount: 30, PaymentInformationId: "B1"},
+			wantIds:    []string{"a", "b"},
+			wantStatus: map[string]InstallmentStatus{"a": InstallmentStatusPaid, "b": InstallmentStatusPaid},
+		},
+		{
+			name:       "credit of a batch with another amount",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorCrdt, Amount: 25, PaymentInformationId: "B1"},
+			wantReason: "the amount differs from the 30.00 of batch B1",
+		},
+		{
+			name:       "debit of a batch",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorDbit, Amount: 30, PaymentInformationId: "B1"},
+			wantReason: "no installment matches",
+		},
+		{
+			name:       "credit by mandate pays the oldest installment",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorCrdt, Amount: 10, MandateReference: "M1"},
+			wantIds:    []string{"a"},
+			wantStatus: map[string]InstallmentStatus{"a": InstallmentStatusPaid},
+		},
+		{
+			name:       "return by mandate returns the latest installment",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorDbit, Amount: 10, MandateReference: "M1"},
+			wantIds:    []string{"d"},
+			wantStatus: map[string]InstallmentStatus{"d": InstallmentStatusReturned},
+		},
+		{
+			name:       "unknown end-to-end id falls back to the mandate",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorCrdt, Amount: 20, EndToEndId: "E2E-X", MandateReference: "M2"},
+			wantIds:    []string{"b"},
+			wantStatus: map[string]InstallmentStatus{"b": InstallmentStatusPaid},
+		},
+		{
+			name:       "credit by mandate with another amount",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorCrdt, Amount: 12, MandateReference: "M1"},
+			wantReason: "no installment matches",
+		},
+		{
+			name:       "credit by mandate of an installment not collected",
+			entry:      StatementEntryRes{CreditDebit: CreditDebitIndicatorCrdt, Amount: 15, MandateReference: "M3"},
+			wantReason: "no installment matches",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installments, runs := testInstallments()
+			before := append([]InstallmentRes{}, installments...)
+			tt.entry.BookingDate = "2026-10-20"
+
+			r := newReconciler(installments, runs)
+			ids, reason := r.match(tt.entry)
+			if len(ids) != len(tt.wantIds) || (len(ids) > 0 && !reflect.DeepEqual(ids, tt.wantIds)) {
+				t.Errorf("match() = %v, want %v", ids, tt.wantIds)
+			}
+			if (tt.wantReason == "") != (reason == "") || !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("match() reason = %q, want %q", reason, tt.wantReason)
+			}
+			for i, installment := range installments {
+				want, changed := tt.wantStatus[installment.Id]
+				if !changed {
+					want = before[i].Status
+				}
+				if installment.Status != want || r.changed[installment.Id] != changed {
+					t.Errorf("installment %s is %s (changed %v), want %s (changed %v)", installment.Id, installment.Status, r.changed[installment.Id], want, changed)
+				}
+				if changed && want == InstallmentStatusPaid && installment.PaymentDate != tt.entry.BookingDate {
+					t.Errorf("installment %s paid on %q, want %q", installment.Id, installment.PaymentDate, tt.entry.BookingDate)
+				}
+				if changed && want == InstallmentStatusReturned && (installment.ReturnDate != tt.entry.BookingDate || installment.ReturnReason != tt.entry.ReturnReason) {
+					t.Errorf("installment %s returned on %q for %q", installment.Id, installment.ReturnDate, installment.ReturnReason)
+				}
+			}
+		})
+	}
+}
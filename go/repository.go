@@ -0,0 +1,981 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	collectionCustomers    = "customers"
+	collectionAddresses    = "addresses"
+	collectionBankDetails  = "bankDetails"
+	collectionContracts    = "contracts"
+	collectionEmployees    = "employees"
+	collectionQuotes       = "quotes"
+	collectionVersions     = "contractVersions"
+	collectionClaims       = "claims"
+	collectionDocuments    = "documents"
+	collectionMandates     = "mandates"
+	collectionInstallments = "installments"
+	collectionBillingRuns  = "billingRuns"
+	collectionStatements   = "bankStatements"
+	collectionEntries      = "statementEntries"
+	collectionDunningCases = "dunningCases"
+	collectionAccounts     = "accounts"
+)
+
+// defaultPageSize is used by list operations when the client does not request a page size
+const defaultPageSize = 20
+
+// Repository defines the persistence operations required by the api services
+type Repository interface {
+	CreateCustomer(context.Context, CustomerRes) error
+	GetCustomer(context.Context, string) (CustomerRes, error)
+	UpdateCustomer(context.Context, CustomerRes) error
+	DeleteCustomer(context.Context, string) error
+	ListCustomers(context.Context) ([]CustomerRes, error)
+	FindCustomerByTaxId(context.Context, string) (CustomerRes, error)
+
+	GetAddress(context.Context, string) (Address, error)
+	SaveAddress(context.Context, Address) error
+	GetBankDetails(context.Context, string) (BankDetails, error)
+	SaveBankDetails(context.Context, BankDetails) error
+
+	CreateContract(context.Context, ContractRes) error
+	GetContract(context.Context, string) (ContractRes, error)
+	UpdateContract(context.Context, ContractRes) error
+	ListContracts(context.Context) ([]ContractRes, error)
+	ListCustomerContracts(context.Context, string) ([]ContractRes, error)
+	CreateContractVersion(context.Context, ContractVersion) error
+	ListContractVersions(context.Context, string) ([]ContractVersion, error)
+
+	CreateClaim(context.Context, ClaimRes) error
+	GetClaim(context.Context, string) (ClaimRes, error)
+	UpdateClaim(context.Context, ClaimRes) error
+	ListContractClaims(context.Context, string) ([]ClaimRes, error)
+	ListCustomerClaims(context.Context, string) ([]ClaimRes, error)
+	ListClaims(context.Context) ([]ClaimRes, error)
+
+	CreateDocument(context.Context, DocumentRes) error
+	GetDocument(context.Context, string) (DocumentRes, error)
+	ListClaimDocuments(context.Context, string) ([]DocumentRes, error)
+	ListContractDocuments(context.Context, string) ([]DocumentRes, error)
+
+	CreateMandate(context.Context, MandateRes) error
+	GetMandate(context.Context, string) (MandateRes, error)
+	UpdateMandate(context.Context, MandateRes) error
+	ListContractMandates(context.Context, string) ([]MandateRes, error)
+	ListCustomerMandates(context.Context, string) ([]MandateRes, error)
+	ListMandates(context.Context) ([]MandateRes, error)
+
+	CreateInstallment(context.Context, InstallmentRes) error
+	GetInstallment(context.Context, string) (InstallmentRes, error)
+	UpdateInstallment(context.Context, InstallmentRes) error
+	ListContractInstallments(context.Context, string) ([]InstallmentRes, error)
+	ListInstallments(context.Context) ([]InstallmentRes, error)
+	CreateBillingRun(context.Context, BillingRunRes) error
+	GetBillingRun(context.Context, string) (BillingRunRes, error)
+	ListBillingRuns(context.Context) ([]BillingRunRes, error)
+
+	CreateBankStatement(context.Context, BankStatementRes) error
+	GetBankStatement(context.Context, string) (BankStatementRes, error)
+	ListBankStatements(context.Context) ([]BankStatementRes, error)
+	CreateStatementEntry(context.Context, StatementEntryRes) error
+	GetStatementEntry(context.Context, string) (StatementEntryRes, error)
+	UpdateStatementEntry(context.Context, StatementEntryRes) error
+	ListBankStatementEntries(context.Context, string) ([]StatementEntryRes, error)
+	ListStatementEntries(context.Context) ([]StatementEntryRes, error)
+
+	CreateDunningCase(context.Context, DunningCaseRes) error
+	GetDunningCase(context.Context, string) (DunningCaseRes, error)
+	UpdateDunningCase(context.Context, DunningCaseRes) error
+	ListDunningCases(context.Context) ([]DunningCaseRes, error)
+
+	CreateQuote(context.Context, QuoteRes) error
+	GetQuote(context.Context, string) (QuoteRes, error)
+	UpdateQuote(context.Context, QuoteRes) error
+
+	CreateEmployee(context.Context, EmployeeRes) error
+	GetEmployee(context.Context, string) (EmployeeRes, error)
+	UpdateEmployee(context.Context, EmployeeRes) error
+	ListEmployees(context.Context) ([]EmployeeRes, error)
+
+	CreateAccount(context.Context, Account) error
+	GetAccount(context.Context, string) (Account, error)
+	UpdateAccount(context.Context, Account) error
+	ListAccounts(context.Context) ([]Account, error)
+	DeleteAccount(context.Context, string) error
+	FindAccountByEmail(context.Context, string) (Account, error)
+}
+
+// customerRecord is the stored form of a CustomerRes. Address and bank details are kept
+// in their own collections and referenced by id.
+type customerRecord struct {
+	Id                   string      `json:"id"`
+	Email                string      `json:"email"`
+	FirstName            string      `json:"firstName"`
+	LastName             string      `json:"lastName"`
+	Title                string      `json:"title,omitempty"`
+	FamilyStatus         string      `json:"familyStatus"`
+	BirthDate            string      `json:"birthDate"`
+	SocialSecurityNumber string      `json:"socialSecurityNumber"`
+	TaxId                string      `json:"taxId"`
+	JobStatus            string      `json:"jobStatus"`
+	AddressId            string      `json:"addressId"`
+	BankDetailsId        string      `json:"bankDetailsId"`
+	AdvisorId            string      `json:"advisorId,omitempty"`
+	Created              *AuditStamp `json:"created,omitempty"`
+	Modified             *AuditStamp `json:"modified,omitempty"`
+}
+
+// employeeRecord is the stored form of an EmployeeRes
+type employeeRecord struct {
+	Id            string         `json:"id"`
+	FirstName     string         `json:"firstName"`
+	LastName      string         `json:"lastName"`
+	AddressId     string         `json:"addressId"`
+	Email         string         `json:"email,omitempty"`
+	Roles         []EmployeeRole `json:"roles,omitempty"`
+	Status        EmployeeStatus `json:"status,omitempty"`
+	DeactivatedAt string         `json:"deactivatedAt,omitempty"`
+}
+
+// StoreRepository implements the Repository on top of any Store
+type StoreRepository struct {
+	store Store
+}
+
+// NewRepository creates a repository persisting its entities in the given store
+func NewRepository(store Store) Repository {
+	return &StoreRepository{store: store}
+}
+
+// CreateCustomer stores a new customer together with its address and bank details
+func (r *StoreRepository) CreateCustomer(ctx context.Context, customer CustomerRes) error {
+	if err := r.store.Get(collectionCustomers, customer.Id, &customerRecord{}); err == nil {
+		return fmt.Errorf("customer %s already exists", customer.Id)
+	}
+
+	return r.saveCustomer(ctx, customer)
+}
+
+// GetCustomer loads a customer and resolves its address and bank details
+func (r *StoreRepository) GetCustomer(ctx context.Context, id string) (CustomerRes, error) {
+	record := customerRecord{}
+	if err := r.store.Get(collectionCustomers, id, &record); err != nil {
+		return CustomerRes{}, err
+	}
+
+	return r.resolveCustomer(ctx, record)
+}
+
+// UpdateCustomer replaces an existing customer
+func (r *StoreRepository) UpdateCustomer(ctx context.Context, customer CustomerRes) error {
+	if err := r.store.Get(collectionCustomers, customer.Id, &customerRecord{}); err != nil {
+		return err
+	}
+
+	return r.saveCustomer(ctx, customer)
+}
+
+// DeleteCustomer removes a customer together with its address and bank details
+func (r *StoreRepository) DeleteCustomer(ctx context.Context, id string) error {
+	record := customerRecord{}
+	if err := r.store.Get(collectionCustomers, id, &record); err != nil {
+		return err
+	}
+	if err := r.store.Delete(collectionCustomers, id); err != nil {
+		return err
+	}
+	if err := r.store.Delete(collectionAddresses, record.AddressId); err != nil && err != ErrNotFound {
+		return err
+	}
+	if err := r.store.Delete(collectionBankDetails, record.BankDetailsId); err != nil && err != ErrNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// ListCustomers returns all customers ordered by id
+func (r *StoreRepository) ListCustomers(ctx context.Context) ([]CustomerRes, error) {
+	records, err := listDocuments[customerRecord](r.store, collectionCustomers)
+	if err != nil {
+		return nil, err
+	}
+
+	customers := make([]CustomerRes, 0, len(records))
+	for _, record := range records {
+		customer, err := r.resolveCustomer(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+		customers = append(customers, customer)
+	}
+
+	return customers, nil
+}
+
+// FindCustomerByTaxId returns the customer with the given tax id, or ErrNotFound if there is none
+func (r *StoreRepository) FindCustomerByTaxId(ctx context.Context, taxId string) (CustomerRes, error) {
+	records, err := listDocuments[customerRecord](r.store, collectionCustomers)
+	if err != nil {
+		return CustomerRes{}, err
+	}
+
+	for _, record := range records {
+		if record.TaxId == taxId {
+			return r.resolveCustomer(ctx, record)
+		}
+	}
+
+	return CustomerRes{}, ErrNotFound
+}
+
+func (r *StoreRepository) saveCustomer(ctx context.Context, customer CustomerRes) error {
+	if err := r.SaveAddress(ctx, customer.Address); err != nil {
+		return err
+	}
+	if err := r.SaveBankDetails(ctx, customer.BankDetails); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionCustomers, customer.Id, customerRecord{
+		Id:                   customer.Id,
+		Email:                customer.Email,
+		FirstName:            customer.FirstName,
+		LastName:             customer.LastName,
+		Title:                customer.Title,
+		FamilyStatus:         customer.FamilyStatus,
+		BirthDate:            customer.BirthDate,
+		SocialSecurityNumber: customer.SocialSecurityNumber,
+		TaxId:                customer.TaxId,
+		JobStatus:            customer.JobStatus,
+		AddressId:            customer.Address.Id,
+		BankDetailsId:        customer.BankDetails.Id,
+		AdvisorId:            customer.AdvisorId,
+		Created:              customer.Created,
+		Modified:             customer.Modified,
+	})
+}
+
+func (r *StoreRepository) resolveCustomer(ctx context.Context, record customerRecord) (CustomerRes, error) {
+	address, err := r.GetAddress(ctx, record.AddressId)
+	if err != nil {
+		return CustomerRes{}, fmt.Errorf("address of customer %s: %w", record.Id, err)
+	}
+	bankDetails, err := r.GetBankDetails(ctx, record.BankDetailsId)
+	if err != nil {
+		return CustomerRes{}, fmt.Errorf("bank details of customer %s: %w", record.Id, err)
+	}
+
+	return CustomerRes{
+		Id:                   record.Id,
+		Email:                record.Email,
+		FirstName:            record.FirstName,
+		LastName:             record.LastName,
+		Title:                record.Title,
+		FamilyStatus:         record.FamilyStatus,
+		BirthDate:            record.BirthDate,
+		SocialSecurityNumber: record.SocialSecurityNumber,
+		TaxId:                record.TaxId,
+		JobStatus:            record.JobStatus,
+		Address:              address,
+		BankDetails:          bankDetails,
+		AdvisorId:            record.AdvisorId,
+		Created:              record.Created,
+		Modified:             record.Modified,
+	}, nil
+}
+
+// GetAddress loads an address
+func (r *StoreRepository) GetAddress(ctx context.Context, id string) (Address, error) {
+	address := Address{}
+	err := r.store.Get(collectionAddresses, id, &address)
+	return address, err
+}
+
+// SaveAddress creates or replaces an address
+func (r *StoreRepository) SaveAddress(ctx context.Context, address Address) error {
+	return r.store.Put(collectionAddresses, address.Id, address)
+}
+
+// GetBankDetails loads bank details
+func (r *StoreRepository) GetBankDetails(ctx context.Context, id string) (BankDetails, error) {
+	bankDetails := BankDetails{}
+	err := r.store.Get(collectionBankDetails, id, &bankDetails)
+	return bankDetails, err
+}
+
+// SaveBankDetails creates or replaces bank details
+func (r *StoreRepository) SaveBankDetails(ctx context.Context, bankDetails BankDetails) error {
+	return r.store.Put(collectionBankDetails, bankDetails.Id, bankDetails)
+}
+
+// CreateContract stores a new contract
+func (r *StoreRepository) CreateContract(ctx context.Context, contract ContractRes) error {
+	if err := r.store.Get(collectionContracts, contract.Id, &ContractRes{}); err == nil {
+		return fmt.Errorf("contract %s already exists", contract.Id)
+	}
+
+	return r.store.Put(collectionContracts, contract.Id, contract)
+}
+
+// GetContract loads a contract
+func (r *StoreRepository) GetContract(ctx context.Context, id string) (ContractRes, error) {
+	contract := ContractRes{}
+	err := r.store.Get(collectionContracts, id, &contract)
+	return withDefaultStatus(contract), err
+}
+
+// UpdateContract replaces an existing contract
+func (r *StoreRepository) UpdateContract(ctx context.Context, contract ContractRes) error {
+	if err := r.store.Get(collectionContracts, contract.Id, &ContractRes{}); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionContracts, contract.Id, contract)
+}
+
+// ListContracts returns all contracts ordered by id
+func (r *StoreRepository) ListContracts(ctx context.Context) ([]ContractRes, error) {
+	contracts, err := listDocuments[ContractRes](r.store, collectionContracts)
+	for i := range contracts {
+		contracts[i] = withDefaultStatus(contracts[i])
+	}
+
+	return contracts, err
+}
+
+// withDefaultStatus marks contracts stored before statuses were introduced as active
+func withDefaultStatus(contract ContractRes) ContractRes {
+	if contract.Status == "" && contract.Id != "" {
+		contract.Status = ContractStatusActive
+	}
+
+	return contract
+}
+
+// ListCustomerContracts returns the contracts of a customer ordered by id
+func (r *StoreRepository) ListCustomerContracts(ctx context.Context, customerId string) ([]ContractRes, error) {
+	contracts, err := r.ListContracts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]ContractRes, 0)
+	for _, contract := range contracts {
+		if contract.CustomerId == customerId {
+			filtered = append(filtered, contract)
+		}
+	}
+
+	return filtered, nil
+}
+
+// CreateContractVersion stores a new version of a contract. Versions are immutable once stored.
+func (r *StoreRepository) CreateContractVersion(ctx context.Context, version ContractVersion) error {
+	id := contractVersionId(version.ContractId, version.Version)
+	if err := r.store.Get(collectionVersions, id, &ContractVersion{}); err == nil {
+		return fmt.Errorf("version %d of contract %s already exists", version.Version, version.ContractId)
+	}
+
+	return r.store.Put(collectionVersions, id, version)
+}
+
+// ListContractVersions returns the versions of a contract, oldest first
+func (r *StoreRepository) ListContractVersions(ctx context.Context, contractId string) ([]ContractVersion, error) {
+	versions, err := listDocuments[ContractVersion](r.store, collectionVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]ContractVersion, 0)
+	for _, version := range versions {
+		if version.ContractId == contractId {
+			filtered = append(filtered, version)
+		}
+	}
+
+	return filtered, nil
+}
+
+// contractVersionId builds the id of a contract version so that the versions of a contract are listed in order
+func contractVersionId(contractId string, version int32) string {
+	return fmt.Sprintf("%s/%06d", contractId, version)
+}
+
+// CreateClaim stores a new claim
+func (r *StoreRepository) CreateClaim(ctx context.Context, claim ClaimRes) error {
+	if err := r.store.Get(collectionClaims, claim.Id, &ClaimRes{}); err == nil {
+		return fmt.Errorf("claim %s already exists", claim.Id)
+	}
+
+	return r.store.Put(collectionClaims, claim.Id, claim)
+}
+
+// GetClaim loads a claim
+func (r *StoreRepository) GetClaim(ctx context.Context, id string) (ClaimRes, error) {
+	claim := ClaimRes{}
+	err := r.store.Get(collectionClaims, id, &claim)
+	return withDefaultClaimStatus(claim), err
+}
+
+// UpdateClaim replaces an existing claim
+func (r *StoreRepository) UpdateClaim(ctx context.Context, claim ClaimRes) error {
+	if err := r.store.Get(collectionClaims, claim.Id, &ClaimRes{}); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionClaims, claim.Id, claim)
+}
+
+// ListContractClaims returns the claims of a contract ordered by id
+func (r *StoreRepository) ListContractClaims(ctx context.Context, contractId string) ([]ClaimRes, error) {
+	return r.listClaims(func(claim ClaimRes) bool {
+		return claim.ContractId == contractId
+	})
+}
+
+// ListCustomerClaims returns the claims of all contracts of a customer ordered by id
+func (r *StoreRepository) ListCustomerClaims(ctx context.Context, customerId string) ([]ClaimRes, error) {
+	return r.listClaims(func(claim ClaimRes) bool {
+		return claim.CustomerId == customerId
+	})
+}
+
+// ListClaims returns all claims ordered by id
+func (r *StoreRepository) ListClaims(ctx context.Context) ([]ClaimRes, error) {
+	return r.listClaims(func(claim ClaimRes) bool {
+		return true
+	})
+}
+
+func (r *StoreRepository) listClaims(match func(ClaimRes) bool) ([]ClaimRes, error) {
+	claims, err := listDocuments[ClaimRes](r.store, collectionClaims)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]ClaimRes, 0)
+	for _, claim := range claims {
+		if claim = withDefaultClaimStatus(claim); match(claim) {
+			filtered = append(filtered, claim)
+		}
+	}
+
+	return filtered, nil
+}
+
+// withDefaultClaimStatus marks claims stored before the claim workflow was introduced as paid,
+// as they were settled on submission
+func withDefaultClaimStatus(claim ClaimRes) ClaimRes {
+	if claim.Status == "" && claim.Id != "" {
+		claim.Status = ClaimStatusPaid
+	}
+
+	return claim
+}
+
+// CreateDocument stores the metadata of a new document
+func (r *StoreRepository) CreateDocument(ctx context.Context, document DocumentRes) error {
+	if err := r.store.Get(collectionDocuments, document.Id, &DocumentRes{}); err == nil {
+		return fmt.Errorf("document %s already exists", document.Id)
+	}
+
+	return r.store.Put(collectionDocuments, document.Id, document)
+}
+
+// GetDocument loads the metadata of a document
+func (r *StoreRepository) GetDocument(ctx context.Context, id string) (DocumentRes, error) {
+	document := DocumentRes{}
+	err := r.store.Get(collectionDocuments, id, &document)
+	return document, err
+}
+
+// ListClaimDocuments returns the documents uploaded to a claim ordered by id
+func (r *StoreRepository) ListClaimDocuments(ctx context.Context, claimId string) ([]DocumentRes, error) {
+	return r.listDocumentsOf(func(document DocumentRes) bool {
+		return document.ClaimId == claimId
+	})
+}
+
+// ListContractDocuments returns the documents uploaded to a contract ordered by id
+func (r *StoreRepository) ListContractDocuments(ctx context.Context, contractId string) ([]DocumentRes, error) {
+	return r.listDocumentsOf(func(document DocumentRes) bool {
+		return document.ContractId == contractId
+	})
+}
+
+func (r *StoreRepository) listDocumentsOf(match func(DocumentRes) bool) ([]DocumentRes, error) {
+	documents, err := listDocuments[DocumentRes](r.store, collectionDocuments)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]DocumentRes, 0)
+	for _, document := range documents {
+		if match(document) {
+			filtered = append(filtered, document)
+		}
+	}
+
+	return filtered, nil
+}
+
+// CreateMandate stores a new mandate
+func (r *StoreRepository) CreateMandate(ctx context.Context, mandate MandateRes) error {
+	if err := r.store.Get(collectionMandates, mandate.Id, &MandateRes{}); err == nil {
+		return fmt.Errorf("mandate %s already exists", mandate.Id)
+	}
+
+	return r.store.Put(collectionMandates, mandate.Id, mandate)
+}
+
+// GetMandate loads a mandate
+func (r *StoreRepository) GetMandate(ctx context.Context, id string) (MandateRes, error) {
+	mandate := MandateRes{}
+	err := r.store.Get(collectionMandates, id, &mandate)
+	return mandate, err
+}
+
+// UpdateMandate replaces an existing mandate
+func (r *StoreRepository) UpdateMandate(ctx context.Context, mandate MandateRes) error {
+	if err := r.store.Get(collectionMandates, mandate.Id, &MandateRes{}); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionMandates, mandate.Id, mandate)
+}
+
+// ListContractMandates returns the mandates of a contract ordered by id
+func (r *StoreRepository) ListContractMandates(ctx context.Context, contractId string) ([]MandateRes, error) {
+	return r.listMandates(func(mandate MandateRes) bool {
+		return mandate.ContractId == contractId
+	})
+}
+
+// ListCustomerMandates returns the mandates of a customer ordered by id
+func (r *StoreRepository) ListCustomerMandates(ctx context.Context, customerId string) ([]MandateRes, error) {
+	return r.listMandates(func(mandate MandateRes) bool {
+		return mandate.CustomerId == customerId
+	})
+}
+
+// ListMandates returns all mandates ordered by id
+func (r *StoreRepository) ListMandates(ctx context.Context) ([]MandateRes, error) {
+	return r.listMandates(func(mandate MandateRes) bool {
+		return true
+	})
+}
+
+func (r *StoreRepository) listMandates(match func(MandateRes) bool) ([]MandateRes, error) {
+	mandates, err := listDocuments[MandateRes](r.store, collectionMandates)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]MandateRes, 0)
+	for _, mandate := range mandates {
+		if match(mandate) {
+			filtered = append(filtered, mandate)
+		}
+	}
+
+	return filtered, nil
+}
+
+// CreateInstallment stores a new installment
+func (r *StoreRepository) CreateInstallment(ctx context.Context, installment InstallmentRes) error {
+	if err := r.store.Get(collectionInstallments, installment.Id, &InstallmentRes{}); err == nil {
+		return fmt.Errorf("installment %s already exists", installment.Id)
+	}
+
+	return r.store.Put(collectionInstallments, installment.Id, installment)
+}
+
+// GetInstallment loads an installment
+func (r *StoreRepository) GetInstallment(ctx context.Context, id string) (InstallmentRes, error) {
+	installment := InstallmentRes{}
+	err := r.store.Get(collectionInstallments, id, &installment)
+	return installment, err
+}
+
+// UpdateInstallment replaces an existing installment
+func (r *StoreRepository) UpdateInstallment(ctx context.Context, installment InstallmentRes) error {
+	if err := r.store.Get(collectionInstallments, installment.Id, &InstallmentRes{}); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionInstallments, installment.Id, installment)
+}
+
+// ListContractInstallments returns the installments of a contract ordered by id
+func (r *StoreRepository) ListContractInstallments(ctx context.Context, contractId string) ([]InstallmentRes, error) {
+	installments, err := r.ListInstallments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]InstallmentRes, 0)
+	for _, installment := range installments {
+		if installment.ContractId == contractId {
+			filtered = append(filtered, installment)
+		}
+	}
+
+	return filtered, nil
+}
+
+// ListInstallments returns all installments ordered by id
+func (r *StoreRepository) ListInstallments(ctx context.Context) ([]InstallmentRes, error) {
+	return listDocuments[InstallmentRes](r.store, collectionInstallments)
+}
+
+// CreateBillingRun stores a new billing run
+func (r *StoreRepository) CreateBillingRun(ctx context.Context, run BillingRunRes) error {
+	if err := r.store.Get(collectionBillingRuns, run.Id, &BillingRunRes{}); err == nil {
+		return fmt.Errorf("billing run %s already exists", run.Id)
+	}
+
+	return r.store.Put(collectionBillingRuns, run.Id, run)
+}
+
+// GetBillingRun loads a billing run
+func (r *StoreRepository) GetBillingRun(ctx context.Context, id string) (BillingRunRes, error) {
+	run := BillingRunRes{}
+	err := r.store.Get(collectionBillingRuns, id, &run)
+	return run, err
+}
+
+// ListBillingRuns returns all billing runs ordered by id
+func (r *StoreRepository) ListBillingRuns(ctx context.Context) ([]BillingRunRes, error) {
+	return listDocuments[BillingRunRes](r.store, collectionBillingRuns)
+}
+
+// CreateBankStatement stores a new bank statement without its entries
+func (r *StoreRepository) CreateBankStatement(ctx context.Context, statement BankStatementRes) error {
+	if err := r.store.Get(collectionStatements, statement.Id, &BankStatementRes{}); err == nil {
+		return fmt.Errorf("bank statement %s already exists", statement.Id)
+	}
+	statement.Entries = nil
+
+	return r.store.Put(collectionStatements, statement.Id, statement)
+}
+
+// GetBankStatement loads a bank statement without its entries
+func (r *StoreRepository) GetBankStatement(ctx context.Context, id string) (BankStatementRes, error) {
+	statement := BankStatementRes{}
+	err := r.store.Get(collectionStatements, id, &statement)
+	return statement, err
+}
+
+// ListBankStatements returns all bank statements without their entries ordered by id
+func (r *StoreRepository) ListBankStatements(ctx context.Context) ([]BankStatementRes, error) {
+	return listDocuments[BankStatementRes](r.store, collectionStatements)
+}
+
+// CreateStatementEntry stores a new entry of a bank statement
+func (r *StoreRepository) CreateStatementEntry(ctx context.Context, entry StatementEntryRes) error {
+	if err := r.store.Get(collectionEntries, entry.Id, &StatementEntryRes{}); err == nil {
+		return fmt.Errorf("statement entry %s already exists", entry.Id)
+	}
+
+	return r.store.Put(collectionEntries, entry.Id, entry)
+}
+
+// GetStatementEntry loads an entry of a bank statement
+func (r *StoreRepository) GetStatementEntry(ctx context.Context, id string) (StatementEntryRes, error) {
+	entry := StatementEntryRes{}
+	err := r.store.Get(collectionEntries, id, &entry)
+	return entry, err
+}
+
+// UpdateStatementEntry replaces an existing entry of a bank statement
+func (r *StoreRepository) UpdateStatementEntry(ctx context.Context, entry StatementEntryRes) error {
+	if err := r.store.Get(collectionEntries, entry.Id, &StatementEntryRes{}); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionEntries, entry.Id, entry)
+}
+
+// ListBankStatementEntries returns the entries of a bank statement ordered by id
+func (r *StoreRepository) ListBankStatementEntries(ctx context.Context, statementId string) ([]StatementEntryRes, error) {
+	entries, err := r.ListStatementEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]StatementEntryRes, 0)
+	for _, entry := range entries {
+		if entry.BankStatementId == statementId {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered, nil
+}
+
+// ListStatementEntries returns the entries of all bank statements ordered by id
+func (r *StoreRepository) ListStatementEntries(ctx context.Context) ([]StatementEntryRes, error) {
+	return listDocuments[StatementEntryRes](r.store, collectionEntries)
+}
+
+// CreateDunningCase stores a new dunning case
+func (r *StoreRepository) CreateDunningCase(ctx context.Context, dunningCase DunningCaseRes) error {
+	if err := r.store.Get(collectionDunningCases, dunningCase.Id, &DunningCaseRes{}); err == nil {
+		return fmt.Errorf("dunning case %s already exists", dunningCase.Id)
+	}
+
+	return r.store.Put(collectionDunningCases, dunningCase.Id, dunningCase)
+}
+
+// GetDunningCase loads a dunning case
+func (r *StoreRepository) GetDunningCase(ctx context.Context, id string) (DunningCaseRes, error) {
+	dunningCase := DunningCaseRes{}
+	err := r.store.Get(collectionDunningCases, id, &dunningCase)
+	return dunningCase, err
+}
+
+// UpdateDunningCase replaces an existing dunning case
+func (r *StoreRepository) UpdateDunningCase(ctx context.Context, dunningCase DunningCaseRes) error {
+	if err := r.store.Get(collectionDunningCases, dunningCase.Id, &DunningCaseRes{}); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionDunningCases, dunningCase.Id, dunningCase)
+}
+
+// ListDunningCases returns all dunning cases ordered by id
+func (r *StoreRepository) ListDunningCases(ctx context.Context) ([]DunningCaseRes, error) {
+	return listDocuments[DunningCaseRes](r.store, collectionDunningCases)
+}
+
+// CreateQuote stores a new quote
+func (r *StoreRepository) CreateQuote(ctx context.Context, quote QuoteRes) error {
+	if err := r.store.Get(collectionQuotes, quote.Id, &QuoteRes{}); err == nil {
+		return fmt.Errorf("quote %s already exists", quote.Id)
+	}
+
+	return r.store.Put(collectionQuotes, quote.Id, quote)
+}
+
+// GetQuote loads a quote
+func (r *StoreRepository) GetQuote(ctx context.Context, id string) (QuoteRes, error) {
+	quote := QuoteRes{}
+	err := r.store.Get(collectionQuotes, id, &quote)
+	return quote, err
+}
+
+// UpdateQuote replaces an existing quote
+func (r *StoreRepository) UpdateQuote(ctx context.Context, quote QuoteRes) error {
+	if err := r.store.Get(collectionQuotes, quote.Id, &QuoteRes{}); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionQuotes, quote.Id, quote)
+}
+
+// CreateEmployee stores a new employee together with its address
+func (r *StoreRepository) CreateEmployee(ctx context.Context, employee EmployeeRes) error {
+	if err := r.store.Get(collectionEmployees, employee.Id, &employeeRecord{}); err == nil {
+		return fmt.Errorf("employee %s already exists", employee.Id)
+	}
+
+	return r.saveEmployee(ctx, employee)
+}
+
+// GetEmployee loads an employee and resolves its address
+func (r *StoreRepository) GetEmployee(ctx context.Context, id string) (EmployeeRes, error) {
+	record := employeeRecord{}
+	if err := r.store.Get(collectionEmployees, id, &record); err != nil {
+		return EmployeeRes{}, err
+	}
+
+	return r.resolveEmployee(ctx, record)
+}
+
+// UpdateEmployee replaces an existing employee
+func (r *StoreRepository) UpdateEmployee(ctx context.Context, employee EmployeeRes) error {
+	if err := r.store.Get(collectionEmployees, employee.Id, &employeeRecord{}); err != nil {
+		return err
+	}
+
+	return r.saveEmployee(ctx, employee)
+}
+
+// ListEmployees returns all employees ordered by id
+func (r *StoreRepository) ListEmployees(ctx context.Context) ([]EmployeeRes, error) {
+	records, err := listDocuments[employeeRecord](r.store, collectionEmployees)
+	if err != nil {
+		return nil, err
+	}
+
+	employees := make([]EmployeeRes, 0, len(records))
+	for _, record := range records {
+		employee, err := r.resolveEmployee(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, employee)
+	}
+
+	return employees, nil
+}
+
+func (r *StoreRepository) saveEmployee(ctx context.Context, employee EmployeeRes) error {
+	if err := r.SaveAddress(ctx, employee.Address); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionEmployees, employee.Id, employeeRecord{
+		Id:            employee.Id,
+		FirstName:     employee.FirstName,
+		LastName:      employee.LastName,
+		AddressId:     employee.Address.Id,
+		Email:         employee.Email,
+		Roles:         employee.Roles,
+		Status:        employee.Status,
+		DeactivatedAt: employee.DeactivatedAt,
+	})
+}
+
+// resolveEmployee loads the address of an employee. Employees stored before they had roles and a status
+// are active employees with the role employee.
+func (r *StoreRepository) resolveEmployee(ctx context.Context, record employeeRecord) (EmployeeRes, error) {
+	address, err := r.GetAddress(ctx, record.AddressId)
+	if err != nil {
+		return EmployeeRes{}, fmt.Errorf("address of employee %s: %w", record.Id, err)
+	}
+
+	employee := EmployeeRes{
+		Id:            record.Id,
+		FirstName:     record.FirstName,
+		LastName:      record.LastName,
+		Address:       address,
+		Email:         record.Email,
+		Roles:         record.Roles,
+		Status:        record.Status,
+		DeactivatedAt: record.DeactivatedAt,
+	}
+	if len(employee.Roles) == 0 {
+		employee.Roles = []EmployeeRole{EmployeeRoleEmployee}
+	}
+	if employee.Status == "" {
+		employee.Status = EmployeeStatusActive
+	}
+
+	return employee, nil
+}
+
+// CreateAccount stores a new user account
+func (r *StoreRepository) CreateAccount(ctx context.Context, account Account) error {
+	if err := r.store.Get(collectionAccounts, account.Id, &Account{}); err == nil {
+		return fmt.Errorf("account %s already exists", account.Id)
+	}
+
+	return r.store.Put(collectionAccounts, account.Id, account)
+}
+
+// GetAccount loads a user account
+func (r *StoreRepository) GetAccount(ctx context.Context, id string) (Account, error) {
+	account := Account{}
+	err := r.store.Get(collectionAccounts, id, &account)
+	return account, err
+}
+
+// UpdateAccount replaces an existing user account
+func (r *StoreRepository) UpdateAccount(ctx context.Context, account Account) error {
+	if err := r.store.Get(collectionAccounts, account.Id, &Account{}); err != nil {
+		return err
+	}
+
+	return r.store.Put(collectionAccounts, account.Id, account)
+}
+
+// ListAccounts returns all user accounts ordered by id
+func (r *StoreRepository) ListAccounts(ctx context.Context) ([]Account, error) {
+	return listDocuments[Account](r.store, collectionAccounts)
+}
+
+// DeleteAccount removes a user account
+func (r *StoreRepository) DeleteAccount(ctx context.Context, id string) error {
+	return r.store.Delete(collectionAccounts, id)
+}
+
+// FindAccountByEmail returns the account with the given email address, ignoring case, or ErrNotFound if there is none
+func (r *StoreRepository) FindAccountByEmail(ctx context.Context, email string) (Account, error) {
+	accounts, err := r.ListAccounts(ctx)
+	if err != nil {
+		return Account{}, err
+	}
+
+	for _, account := range accounts {
+		if normalizeEmail(account.Email) == normalizeEmail(email) {
+			return account, nil
+		}
+	}
+
+	return Account{}, ErrNotFound
+}
+
+// listDocuments decodes every document of a collection into a T
+func listDocuments[T any](store Store, collection string) ([]T, error) {
+	documents := make([]T, 0)
+	err := store.List(collection, func(id string, data []byte) error {
+		var document T
+		if err := json.Unmarshal(data, &document); err != nil {
+			return fmt.Errorf("decoding %s %s: %w", collection, id, err)
+		}
+		documents = append(documents, document)
+		return nil
+	})
+
+	return documents, err
+}
+
+// paginate returns the requested page of items. A zero page or pageSize selects the first page
+// or the default page size respectively.
+func paginate[T any](items []T, page int32, pageSize int32) []T {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+
+	start := int(page-1) * int(pageSize)
+	if start >= len(items) {
+		return []T{}
+	}
+	end := start + int(pageSize)
+	if end > len(items) {
+		end = len(items)
+	}
+
+	return items[start:end]
+}
+
+// newId generates a random version 4 UUID
+func newId() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
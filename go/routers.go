@@ -10,15 +10,19 @@
 package openapi
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"time"
 	"github.com/gorilla/mux"
 	"io"
+	"mime"
 	"mime/multipart"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -42,14 +46,20 @@ const errMsgRequiredMissing = "required parameter is missing"
 const errMsgMinValueConstraint = "provided parameter is not respecting minimum value constraint"
 const errMsgMaxValueConstraint = "provided parameter is not respecting maximum value constraint"
 
-// NewRouter creates a new router for any number of api routers
-func NewRouter(routers ...Router) *mux.Router {
+// NewRouter creates a new router for any number of api routers. The requests of every route are authenticated by auth
+// and authorized by authz.
+func NewRouter(auth *Authenticator, authz *Authorizer, routers ...Router) *mux.Router {
 	router := mux.NewRouter().StrictSlash(true)
 	for _, api := range routers {
-		for name, route := range api.Routes() {
+		routes := api.Routes()
+		for _, name := range sortedRouteNames(routes) {
+			route := routes[name]
 			var handler http.Handler
 			handler = route.HandlerFunc
+			handler = authz.Authorize(handler, name)
+			handler = auth.Authenticate(handler, name)
 			handler = Logger(handler, name)
+			handler = Tracer(handler)
 
 			router.
 				Methods(route.Method).
@@ -58,28 +68,50 @@ func NewRouter(routers ...Router) *mux.Router {
 				Handler(handler)
 		}
 	}
+	router.NotFoundHandler = Tracer(ProblemHandler(http.StatusNotFound))
+	router.MethodNotAllowedHandler = Tracer(ProblemHandler(http.StatusMethodNotAllowed))
 
 	return router
 }
 
+// sortedRouteNames orders routes so that literal path segments are registered before path variables,
+// e.g. /v1/customers/search before /v1/customers/{customerId}. Routes are otherwise ordered by name.
+func sortedRouteNames(routes Routes) []string {
+	names := make([]string, 0, len(routes))
+	for name := range routes {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		vi, vj := strings.Count(routes[names[i]].Pattern, "{"), strings.Count(routes[names[j]].Pattern, "{")
+		if vi != vj {
+			return vi < vj
+		}
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
 // EncodeJSONResponse uses the json encoder to write an interface to the http response with an optional status code
 func EncodeJSONResponse(i interface{}, status *int, w http.ResponseWriter) error {
 	wHeader := w.Header()
 
 	f, ok := i.(*os.File)
 	if ok {
-		data, err := io.ReadAll(f)
-		if err != nil {
+		// Only the start of the file is read to detect its content type, the file is streamed to the response
+		head := make([]byte, 512)
+		n, err := io.ReadFull(f, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
 			return err
 		}
-		wHeader.Set("Content-Type", http.DetectContentType(data))
-		wHeader.Set("Content-Disposition", "attachment; filename="+f.Name())
+		wHeader.Set("Content-Type", http.DetectContentType(head[:n]))
+		wHeader.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filepath.Base(f.Name())}))
 		if status != nil {
 			w.WriteHeader(*status)
 		} else {
 			w.WriteHeader(http.StatusOK)
 		}
-		_, err = w.Write(data)
+		_, err = io.Copy(w, io.MultiReader(bytes.NewReader(head[:n]), f))
 		return err
 	}
 	wHeader.Set("Content-Type", "application/json; charset=UTF-8")
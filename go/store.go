@@ -0,0 +1,122 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrNotFound is returned by a Store when the requested document does not exist
+	ErrNotFound = errors.New("not found")
+)
+
+// Store defines a document store backing the Repository. Documents are grouped in collections,
+// addressed by id and encoded as JSON.
+type Store interface {
+	// Get decodes the document stored under id into v or returns ErrNotFound
+	Get(collection string, id string, v interface{}) error
+	// Put encodes v and stores it under id, replacing any previous document
+	Put(collection string, id string, v interface{}) error
+	// Delete removes the document stored under id or returns ErrNotFound
+	Delete(collection string, id string) error
+	// List calls fn with every document in the collection, ordered by id
+	List(collection string, fn func(id string, data []byte) error) error
+	// Close releases the resources held by the store
+	Close() error
+}
+
+// MemoryStore is a Store keeping all documents in memory. Its contents are lost when the process exits.
+type MemoryStore struct {
+	mu          sync.RWMutex
+	collections map[string]map[string][]byte
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		collections: map[string]map[string][]byte{},
+	}
+}
+
+// Get decodes the document stored under id into v
+func (s *MemoryStore) Get(collection string, id string, v interface{}) error {
+	s.mu.RLock()
+	data, ok := s.collections[collection][id]
+	s.mu.RUnlock()
+	if !ok {
+		return ErrNotFound
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// Put encodes v and stores it under id
+func (s *MemoryStore) Put(collection string, id string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	documents, ok := s.collections[collection]
+	if !ok {
+		documents = map[string][]byte{}
+		s.collections[collection] = documents
+	}
+	documents[id] = data
+
+	return nil
+}
+
+// Delete removes the document stored under id
+func (s *MemoryStore) Delete(collection string, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.collections[collection][id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.collections[collection], id)
+
+	return nil
+}
+
+// List calls fn with every document in the collection, ordered by id
+func (s *MemoryStore) List(collection string, fn func(id string, data []byte) error) error {
+	s.mu.RLock()
+	documents := s.collections[collection]
+	ids := make([]string, 0, len(documents))
+	for id := range documents {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	data := make([][]byte, len(ids))
+	for i, id := range ids {
+		data[i] = documents[id]
+	}
+	s.mu.RUnlock()
+
+	for i, id := range ids {
+		if err := fn(id, data[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close is a no-op for the in-memory store
+func (s *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// BoltStore is a Store persisting documents in an embedded bbolt database file.
+// Every collection is kept in its own bucket.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens or creates the database file at path
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Get decodes the document stored under id into v
+func (s *BoltStore) Get(collection string, id string, v interface{}) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(collection))
+		if bucket == nil {
+			return ErrNotFound
+		}
+		data := bucket.Get([]byte(id))
+		if data == nil {
+			return ErrNotFound
+		}
+
+		return json.Unmarshal(data, v)
+	})
+}
+
+// Put encodes v and stores it under id
+func (s *BoltStore) Put(collection string, id string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(collection))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(id), data)
+	})
+}
+
+// Delete removes the document stored under id
+func (s *BoltStore) Delete(collection string, id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(collection))
+		if bucket == nil || bucket.Get([]byte(id)) == nil {
+			return ErrNotFound
+		}
+
+		return bucket.Delete([]byte(id))
+	})
+}
+
+// List calls fn with every document in the collection, ordered by id
+func (s *BoltStore) List(collection string, fn func(id string, data []byte) error) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(collection))
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			return fn(string(k), v)
+		})
+	})
+}
+
+// Close closes the underlying database file
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,284 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed tariff_default.json
+var defaultTariffJSON []byte
+
+// Tariff holds the premium tables used by the RateEngine. All factors are multiplicative;
+// keys of the lookup tables are matched case-insensitively against the request values.
+type Tariff struct {
+	// Version identifies the tariff, e.g. 2026-01. Contracts record the version they were priced with.
+	Version string `json:"version"`
+	// ValidFrom is the date from which on new premiums are calculated with this tariff
+	ValidFrom string `json:"validFrom"`
+
+	// BaseRate is the yearly premium before any factor is applied
+	BaseRate float64 `json:"baseRate"`
+	// CoverageRate is added to the base rate for every unit of coverage
+	CoverageRate float64 `json:"coverageRate"`
+	// MinimumRate and MaximumRate bound the final yearly premium
+	MinimumRate float64 `json:"minimumRate"`
+	MaximumRate float64 `json:"maximumRate"`
+
+	Ages          []AgeBand    `json:"ages"`
+	Weights       []WeightBand `json:"weights"`
+	Breeds        FactorTable  `json:"breeds"`
+	Colors        FactorTable  `json:"colors"`
+	Environments  FactorTable  `json:"environments"`
+	Personalities FactorTable  `json:"personalities"`
+	Regions       []Region     `json:"regions"`
+
+	// NeuteredFactor and IntactFactor apply to neutered and not neutered cats respectively
+	NeuteredFactor float64 `json:"neuteredFactor"`
+	IntactFactor   float64 `json:"intactFactor"`
+
+	// Deductible is deducted from the invoice amount of every claim
+	Deductible float64 `json:"deductible"`
+	// CoPayment is the share of the invoice amount after the deductible the customer pays themselves
+	CoPayment float64 `json:"coPayment"`
+
+	// PaymentSurcharges are added to the yearly premium when it is paid in installments, e.g. 0.05 for monthly
+	// payments. Payment frequencies not listed carry no surcharge.
+	PaymentSurcharges map[PaymentFrequency]float64 `json:"paymentSurcharges"`
+}
+
+// AgeBand applies Factor to cats younger than MaxAge years. The last band may use a MaxAge of 0 for "any age".
+type AgeBand struct {
+	MaxAge int     `json:"maxAge"`
+	Factor float64 `json:"factor"`
+}
+
+// WeightBand applies Factor to cats weighing less than MaxWeight gramm. The last band may use a MaxWeight of 0 for "any weight".
+type WeightBand struct {
+	MaxWeight float64 `json:"maxWeight"`
+	Factor    float64 `json:"factor"`
+}
+
+// FactorTable maps request values to factors. Values not listed use the Default factor.
+type FactorTable struct {
+	Default float64            `json:"default"`
+	Values  map[string]float64 `json:"values"`
+}
+
+// Region applies Factor to zip codes between From and To inclusive. Zip codes outside of all regions use a factor of 1.
+type Region struct {
+	Name   string  `json:"name"`
+	From   int     `json:"from"`
+	To     int     `json:"to"`
+	Factor float64 `json:"factor"`
+}
+
+// TariffSource loads every available tariff version
+type TariffSource func() ([]*Tariff, error)
+
+// ParseTariff decodes and validates a tariff in its JSON representation
+func ParseTariff(data []byte) (*Tariff, error) {
+	tariff := &Tariff{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(tariff); err != nil {
+		return nil, err
+	}
+	if err := tariff.Validate(); err != nil {
+		return nil, err
+	}
+
+	return tariff, nil
+}
+
+// ParseTariffYAML decodes and validates a tariff in its YAML representation. The document uses
+// the same field names as the JSON representation.
+func ParseTariffYAML(data []byte) (*Tariff, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	// Unquoted dates such as validFrom: 2026-07-01 are kept as written rather than decoded into a time.Time,
+	// which would be encoded as a timestamp
+	keepTimestamps(&root)
+	var document interface{}
+	if err := root.Decode(&document); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseTariff(data)
+}
+
+// keepTimestamps retags the timestamps of a YAML document as strings
+func keepTimestamps(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!timestamp" {
+		node.Tag = "!!str"
+	}
+	for _, child := range node.Content {
+		keepTimestamps(child)
+	}
+}
+
+// DefaultTariffs is the TariffSource providing the tariff shipped with the server
+func DefaultTariffs() ([]*Tariff, error) {
+	tariff, err := ParseTariff(defaultTariffJSON)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default tariff: %w", err)
+	}
+
+	return []*Tariff{tariff}, nil
+}
+
+// TariffDirectory returns a TariffSource loading every .json, .yaml and .yml file in dir
+func TariffDirectory(dir string) TariffSource {
+	return func() ([]*Tariff, error) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		tariffs := make([]*Tariff, 0, len(entries))
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			var parse func([]byte) (*Tariff, error)
+			switch strings.ToLower(filepath.Ext(entry.Name())) {
+			case ".json":
+				parse = ParseTariff
+			case ".yaml", ".yml":
+				parse = ParseTariffYAML
+			default:
+				continue
+			}
+
+			data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			tariff, err := parse(data)
+			if err != nil {
+				return nil, fmt.Errorf("tariff %s: %w", entry.Name(), err)
+			}
+			tariffs = append(tariffs, tariff)
+		}
+
+		return tariffs, nil
+	}
+}
+
+// Validate checks that the tariff can produce premiums within the bounds of RateRes
+func (t *Tariff) Validate() error {
+	if t.Version == "" {
+		return errors.New("tariff needs a version")
+	}
+	if _, err := time.Parse(dateLayout, t.ValidFrom); err != nil {
+		return fmt.Errorf("tariff validFrom %q is not a valid date", t.ValidFrom)
+	}
+	if t.BaseRate < 0 || t.CoverageRate < 0 {
+		return errors.New("tariff rates must not be negative")
+	}
+	if t.MinimumRate < 0 || t.MaximumRate > 99999 || t.MinimumRate > t.MaximumRate {
+		return fmt.Errorf("tariff bounds [%v, %v] must lie within [0, 99999]", t.MinimumRate, t.MaximumRate)
+	}
+	if t.NeuteredFactor <= 0 || t.IntactFactor <= 0 {
+		return errors.New("tariff neutered and intact factors must be positive")
+	}
+	if t.Deductible < 0 || t.CoPayment < 0 || t.CoPayment >= 1 {
+		return errors.New("tariff deductible must not be negative and coPayment must lie within [0, 1)")
+	}
+	if len(t.Ages) == 0 || len(t.Weights) == 0 {
+		return errors.New("tariff needs at least one age and one weight band")
+	}
+	for i, band := range t.Ages {
+		if band.Factor <= 0 {
+			return fmt.Errorf("age band %d has a non-positive factor", i)
+		}
+		if i > 0 && band.MaxAge != 0 && band.MaxAge <= t.Ages[i-1].MaxAge {
+			return fmt.Errorf("age bands must be ordered by maxAge")
+		}
+	}
+	for i, band := range t.Weights {
+		if band.Factor <= 0 {
+			return fmt.Errorf("weight band %d has a non-positive factor", i)
+		}
+		if i > 0 && band.MaxWeight != 0 && band.MaxWeight <= t.Weights[i-1].MaxWeight {
+			return fmt.Errorf("weight bands must be ordered by maxWeight")
+		}
+	}
+	tables := map[string]FactorTable{
+		"breeds":        t.Breeds,
+		"colors":        t.Colors,
+		"environments":  t.Environments,
+		"personalities": t.Personalities,
+	}
+	for name, table := range tables {
+		if table.Default <= 0 {
+			return fmt.Errorf("%s need a positive default factor", name)
+		}
+		// Keys are matched case-insensitively, so keys differing only in case would make the factor depend on
+		// the iteration order of the map
+		keys := make(map[string]string, len(table.Values))
+		for value, factor := range table.Values {
+			if factor <= 0 {
+				return fmt.Errorf("%s factor for %q must be positive", name, value)
+			}
+			if other, ok := keys[strings.ToLower(value)]; ok {
+				return fmt.Errorf("%s %q and %q differ only in case", name, other, value)
+			}
+			keys[strings.ToLower(value)] = value
+		}
+	}
+	for _, region := range t.Regions {
+		if region.Factor <= 0 || region.From > region.To {
+			return fmt.Errorf("region %q is invalid", region.Name)
+		}
+	}
+	for frequency, surcharge := range t.PaymentSurcharges {
+		if !frequency.IsValid() {
+			return fmt.Errorf("payment surcharge for unknown payment frequency %q", frequency)
+		}
+		if surcharge < 0 || surcharge >= 1 {
+			return fmt.Errorf("payment surcharge for %s must lie within [0, 1)", frequency)
+		}
+	}
+
+	return nil
+}
+
+// paymentSurcharge returns the share of the yearly premium added when it is paid with the frequency
+func (t *Tariff) paymentSurcharge(frequency PaymentFrequency) float64 {
+	return t.PaymentSurcharges[frequency]
+}
+
+// lookup returns the factor for value or the table's default
+func (t FactorTable) lookup(value string) float64 {
+	for key, factor := range t.Values {
+		if strings.EqualFold(key, value) {
+			return factor
+		}
+	}
+
+	return t.Default
+}
@@ -0,0 +1,110 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"strings"
+	"testing"
+)
+
+// tariffYAML is a minimal tariff in YAML, with validFrom written as VALID_FROM
+const tariffYAML = `
+version: 2026-07
+validFrom: VALID_FROM
+baseRate: 60
+coverageRate: 0.004
+minimumRate: 30
+maximumRate: 99999
+ages:
+  - maxAge: 0
+    factor: 1
+weights:
+  - maxWeight: 0
+    factor: 1
+breeds:
+  default: 1
+  values:
+    Bengal: 1.15
+colors:
+  default: 1
+environments:
+  default: 1
+personalities:
+  default: 1
+regions:
+  - name: Berlin
+    from: 10000
+    to: 19999
+    factor: 1.15
+neuteredFactor: 0.9
+intactFactor: 1
+deductible: 50
+coPayment: 0.2
+paymentSurcharges:
+  monthly: 0.05
+`
+
+func TestParseTariffYAML(t *testing.T) {
+	tests := []struct {
+		name      string
+		validFrom string
+		want      string
+		wantErr   bool
+	}{
+		{name: "unquoted date", validFrom: "2026-07-01", want: "2026-07-01"},
+		{name: "quoted date", validFrom: `"2026-07-01"`, want: "2026-07-01"},
+		{name: "timestamp", validFrom: "2026-07-01T00:00:00Z", wantErr: true},
+		{name: "no date", validFrom: "soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tariff, err := ParseTariffYAML([]byte(strings.Replace(tariffYAML, "VALID_FROM", tt.validFrom, 1)))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseTariffYAML() accepted validFrom %s", tt.validFrom)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTariffYAML() error = %v", err)
+			}
+			if tariff.ValidFrom != tt.want {
+				t.Errorf("ValidFrom = %q, want %q", tariff.ValidFrom, tt.want)
+			}
+			if tariff.Version != "2026-07" {
+				t.Errorf("Version = %q, want %q", tariff.Version, "2026-07")
+			}
+		})
+	}
+}
+
+func TestTariffValidateCaseInsensitiveKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]float64
+		wantErr bool
+	}{
+		{name: "distinct keys", values: map[string]float64{"Bengal": 1.15, "Siam": 1.1}},
+		{name: "keys differing in case", values: map[string]float64{"Bengal": 1.15, "bengal": 1.3}, wantErr: true},
+		{name: "keys differing in case only partly", values: map[string]float64{"MaineCoon": 1.3, "Mainecoon": 1.3}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tariff, err := ParseTariff(defaultTariffJSON)
+			if err != nil {
+				t.Fatalf("ParseTariff() error = %v", err)
+			}
+			tariff.Breeds.Values = tt.values
+			if err := tariff.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// TraceIdHeader is the response header carrying the trace id of a request
+const TraceIdHeader = "X-Request-Id"
+
+// patternTraceparent matches a W3C Trace Context traceparent header, capturing its trace id
+var patternTraceparent = regexp.MustCompile(`^[0-9a-f]{2}-([0-9a-f]{32})-[0-9a-f]{16}-[0-9a-f]{2}$`)
+
+// patternRequestId limits the request ids accepted from clients to printable tokens of reasonable length
+var patternRequestId = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+type traceIdKey struct{}
+
+// Tracer assigns each request a trace id, so that error responses can be correlated with the server logs.
+// The trace id is taken from the traceparent or X-Request-Id header of the request, or generated if
+// neither is usable, and is returned in the X-Request-Id header of the response.
+func Tracer(inner http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceId := requestTraceId(r)
+		w.Header().Set(TraceIdHeader, traceId)
+
+		inner.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), traceIdKey{}, traceId)))
+	})
+}
+
+// TraceId returns the trace id assigned to the request of the context by Tracer, or an empty string
+func TraceId(ctx context.Context) string {
+	traceId, _ := ctx.Value(traceIdKey{}).(string)
+	return traceId
+}
+
+// requestTraceId returns the trace id sent by the client, or a new one in the format of a traceparent trace id
+func requestTraceId(r *http.Request) string {
+	if match := patternTraceparent.FindStringSubmatch(r.Header.Get("traceparent")); match != nil {
+		return match[1]
+	}
+	if requestId := r.Header.Get(TraceIdHeader); patternRequestId.MatchString(requestId) {
+		return requestId
+	}
+
+	return strings.ReplaceAll(newId(), "-", "")
+}
@@ -0,0 +1,269 @@
+/*
+ * Cat Insurance API
+ *
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Patterns declared in api/openapi.yaml
+var (
+	patternCapitalized  = regexp.MustCompile(`^[A-Z][a-z]*$`)
+	patternElevenDigits = regexp.MustCompile(`^[0-9]{11}$`)
+	patternHouseNumber  = regexp.MustCompile(`^[0-9]{1,3}[a-z]?$`)
+	patternIban         = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+	patternAccountName  = regexp.MustCompile(`^[A-Z][a-z]*( [A-Z][a-z]*)*$`)
+	patternBic          = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	patternUuid         = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// Codes of the ProblemErrors reported for invalid values
+const (
+	codeRequired = "required"
+	codePattern  = "pattern"
+	codeEnum     = "enum"
+	codeFormat   = "format"
+	codeMinimum  = "minimum"
+	codeMaximum  = "maximum"
+	codeLength   = "length"
+	codeType     = "type"
+	codeUnknown  = "unknown"
+	codeInvalid  = "invalid"
+)
+
+// ValidationError lists every missing or invalid value found in a request. Each error's field is the
+// JSON path of the value, e.g. $.address.zipCode.
+type ValidationError struct {
+	Errors []ProblemError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, problem := range e.Errors {
+		messages = append(messages, problem.Field+": "+problem.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// missingOnly reports whether all errors are about missing required values
+func (e *ValidationError) missingOnly() bool {
+	for _, problem := range e.Errors {
+		if problem.Code != codeRequired {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validator collects the errors found while checking the values of one object. Optional values
+// that are empty are only checked for being present by required.
+type validator struct {
+	errors []ProblemError
+}
+
+// fail records an error of the value at the property name
+func (v *validator) fail(name string, code string, format string, args ...interface{}) {
+	v.errors = append(v.errors, ProblemError{Field: "$." + name, Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+// required checks that none of the elements has its zero value. Elements are checked in the order of their names,
+// so the errors do not depend on the iteration order of the map.
+func (v *validator) required(elements map[string]interface{}) {
+	names := make([]string, 0, len(elements))
+	for name := range elements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if IsZeroValue(elements[name]) {
+			v.fail(name, codeRequired, "is required")
+		}
+	}
+}
+
+// pattern checks that a string matches the regular expression
+func (v *validator) pattern(name string, value string, re *regexp.Regexp) {
+	if value != "" && !re.MatchString(value) {
+		v.fail(name, codePattern, "must match the pattern %s", re)
+	}
+}
+
+// identifier checks that a string matches the regular expression and passes the check of its check digits
+func (v *validator) identifier(name string, value string, re *regexp.Regexp, check func(string) error) {
+	if value == "" {
+		return
+	}
+	if !re.MatchString(value) {
+		v.fail(name, codePattern, "must match the pattern %s", re)
+		return
+	}
+	if err := check(value); err != nil {
+		v.fail(name, codeInvalid, "%v", err)
+	}
+}
+
+// enum checks that a string is one of the allowed values
+func (v *validator) enum(name string, value string, allowed []string) {
+	if value == "" {
+		return
+	}
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	v.fail(name, codeEnum, "must be one of %q", allowed)
+}
+
+// date checks that a string is a full-date as defined by RFC 3339
+func (v *validator) date(name string, value string) {
+	if _, err := time.Parse(dateLayout, value); value != "" && err != nil {
+		v.fail(name, codeFormat, "must be a date in the format YYYY-MM-DD")
+	}
+}
+
+// dateAfter checks that a date lies after the date of the property afterName. Dates that are not valid are
+// only reported by date.
+func (v *validator) dateAfter(name string, value string, afterName string, after string) {
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return
+	}
+	if other, err := time.Parse(dateLayout, after); err == nil && !date.After(other) {
+		v.fail(name, codeInvalid, "must lie after %s", afterName)
+	}
+}
+
+// email checks that a string is a plain email address
+func (v *validator) email(name string, value string) {
+	if address, err := mail.ParseAddress(value); value != "" && (err != nil || address.Address != value) {
+		v.fail(name, codeFormat, "must be an email address")
+	}
+}
+
+// uuid checks that a string is a UUID
+func (v *validator) uuid(name string, value string) {
+	if value != "" && !patternUuid.MatchString(value) {
+		v.fail(name, codeFormat, "must be a UUID")
+	}
+}
+
+// validatePathUuid checks that a path parameter declared with the format uuid is a UUID. Unlike the values of a
+// request body, parameters are reported by their name.
+func validatePathUuid(name string, value string) error {
+	if patternUuid.MatchString(value) {
+		return nil
+	}
+
+	return &ValidationError{Errors: []ProblemError{{Field: name, Code: codeFormat, Message: "must be a UUID"}}}
+}
+
+// minimum checks that a number is not below min
+func (v *validator) minimum(name string, value float64, min float64) {
+	if value < min {
+		v.fail(name, codeMinimum, "must be at least %v", min)
+	}
+}
+
+// maximum checks that a number is not above max
+func (v *validator) maximum(name string, value float64, max float64) {
+	if value > max {
+		v.fail(name, codeMaximum, "must be at most %v", max)
+	}
+}
+
+// length checks that a string has at least min and at most max characters
+func (v *validator) length(name string, value string, min int, max int) {
+	if n := utf8.RuneCountInString(value); value != "" && (n < min || n > max) {
+		v.fail(name, codeLength, "must have between %d and %d characters", min, max)
+	}
+}
+
+// nested records the errors reported by an Assert* function for the object at the property name.
+// Nothing is recorded for an object that is already reported as missing.
+func (v *validator) nested(name string, err error) {
+	if err == nil {
+		return
+	}
+	for _, problem := range v.errors {
+		if problem.Field == "$."+name && problem.Code == codeRequired {
+			return
+		}
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		v.fail(name, codeInvalid, "%v", err)
+		return
+	}
+	for _, problem := range validationErr.Errors {
+		problem.Field = "$." + name + strings.TrimPrefix(problem.Field, "$")
+		v.errors = append(v.errors, problem)
+	}
+}
+
+// validateAll combines the results of the Assert* functions of a request into one ValidationError, so that all
+// missing and invalid values are reported together. Values that are missing, or are within a missing object, are
+// only reported as missing. Any other error is returned as it is.
+func validateAll(errs ...error) error {
+	problems := make([]ProblemError, 0)
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			return err
+		}
+		problems = append(problems, validationErr.Errors...)
+	}
+
+	v := validator{}
+	for _, problem := range problems {
+		if problem.Code == codeRequired || !isWithinMissing(problem.Field, problems) {
+			v.errors = append(v.errors, problem)
+		}
+	}
+
+	return v.err()
+}
+
+// isWithinMissing reports whether the value at field, or an object containing it, is reported as missing
+func isWithinMissing(field string, problems []ProblemError) bool {
+	for _, problem := range problems {
+		if problem.Code != codeRequired {
+			continue
+		}
+		if field == problem.Field || strings.HasPrefix(field, problem.Field+".") || strings.HasPrefix(field, problem.Field+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// err returns a ValidationError with all errors found, or nil if there are none
+func (v *validator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Errors: v.errors}
+}
@@ -10,25 +10,332 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"flag"
+	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	openapi "github.com/GIT_USER_ID/GIT_REPO_ID/go"
 )
 
 func main() {
+	storeKind := flag.String("store", "memory", "persistence backend: memory or bolt")
+	dbPath := flag.String("db", "catinsurance.db", "database file used by the bolt store")
+	policy := openapi.ContractPolicy{}
+	flag.DurationVar(&policy.QuoteValidity, "quote-validity", 30*24*time.Hour, "how long a saved quote can be used to create a contract")
+	flag.DurationVar(&policy.CancellationNotice, "cancellation-notice", 30*24*time.Hour, "notice period for cancelling an active contract")
+	renewalInterval := flag.Duration("renewal-interval", 24*time.Hour, "how often ended contracts are expired or renewed")
+	reviewThreshold := flag.Float64("claim-review-threshold", 1000, "invoice amount above which claims are reviewed by an adjuster")
+	documentDir := flag.String("documents", "documents", "directory the content of uploaded documents is stored in")
+	maxUploadSize := flag.Int64("max-upload-size", openapi.DefaultMaxUploadSize, "maximum size of an uploaded document in bytes")
+	tariffDir := flag.String("tariffs", "", "directory of tariff .json/.yaml files; the built-in tariff is used if empty")
+	creditor := openapi.Creditor{}
+	flag.StringVar(&creditor.Id, "creditor-id", "DE98ZZZ09999999999", "SEPA creditor identifier premiums are collected under; the default is the test identifier of the Deutsche Bundesbank")
+	flag.StringVar(&creditor.Name, "creditor-name", "Cat Insurance", "name of the creditor in direct debits")
+	flag.StringVar(&creditor.Iban, "creditor-iban", "DE02120300000000202051", "IBAN of the account premiums are collected to")
+	flag.StringVar(&creditor.Bic, "creditor-bic", "", "BIC of the account premiums are collected to; derived from German IBANs if empty")
+	bankCodes := flag.String("banks", "", "Bankleitzahlendatei of the Deutsche Bundesbank; the built-in excerpt is used if empty")
+	dunningPath := flag.String("dunning", "", "dunning policy .json file; the built-in policy is used if empty")
+	dunningInterval := flag.Duration("dunning-interval", 24*time.Hour, "how often returned premiums are dunned")
+	auth := authConfig{}
+	flag.StringVar(&auth.secretPath, "jwt-secret", "", "file holding the shared secret of HMAC signed bearer tokens")
+	flag.StringVar(&auth.jwksPath, "jwks", "", "JSON Web Key Set file with the keys of signed bearer tokens")
+	flag.StringVar(&auth.issuer, "jwt-issuer", "", "issuer bearer tokens must be issued by; not checked if empty")
+	flag.StringVar(&auth.audience, "jwt-audience", "", "audience bearer tokens must be meant for; not checked if empty")
+	flag.StringVar(&auth.apiKeysPath, "api-keys", "", "JSON file with the hashed API keys of services")
+	accessPolicyPath := flag.String("access-policy", "", "access policy .json file granting roles access to the routes; the built-in policy is used if empty")
+	sessionTTL := flag.Duration("session-ttl", time.Hour, "how long the session token of a login is valid")
+	portalURL := flag.String("portal-url", "http://localhost:3000", "base URL of the portal the links in emails to customers and employees point to")
+	mail := mailConfig{}
+	flag.StringVar(&mail.smtpAddr, "smtp", "", "SMTP server (host:port) emails are sent through; emails are only logged if empty")
+	flag.StringVar(&mail.from, "mail-from", "Cat Insurance <noreply@localhost>", "sender of emails")
+	flag.StringVar(&mail.username, "smtp-user", "", "user authenticating at the SMTP server, with the password of the SMTP_PASSWORD environment variable")
+	flag.Parse()
+
+	store, err := newStore(*storeKind, *dbPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer store.Close()
+	repo := openapi.NewRepository(store)
+
 	log.Printf("Server started")
 
-	ContractAPIService := openapi.NewContractAPIService()
+	rates, err := newRateEngine(*tariffDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	banks, err := newBankDirectory(*bankCodes)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if creditor.Bic == "" {
+		if bank, ok := banks.LookupIban(creditor.Iban); ok {
+			creditor.Bic = bank.Bic
+		}
+	}
+	if err := creditor.Check(); err != nil {
+		log.Fatal(err)
+	}
+
+	dunningPolicy, err := newDunningPolicy(*dunningPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	accessPolicy, err := newAccessPolicy(*accessPolicyPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth.anonymousRoutes = accessPolicy.AnonymousRoutes()
+	sessionKey, err := newSessionKey(auth.secretPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	authenticator, err := newAuthenticator(auth, sessionKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tokenIssuer, err := openapi.NewTokenIssuer(sessionKey, auth.issuer, auth.audience, *sessionTTL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	authorizer := openapi.NewAuthorizer(accessPolicy, repo)
+
+	blobs, err := openapi.NewFileBlobStore(*documentDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	mailer, err := newMailer(mail)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	AccountAPIService := openapi.NewAccountAPIService(repo, tokenIssuer, mailer, *portalURL)
+	AccountAPIController := openapi.NewAccountAPIController(AccountAPIService)
+
+	// Billing runs, bank statement imports and changes of mandates work on the same installments and mandates,
+	// so they take turns
+	collections := &sync.Mutex{}
+	// Changes of contracts through the API, renewals, expiries and suspensions by dunning take turns as well
+	contracts := &sync.Mutex{}
+
+	BillingAPIService := openapi.NewBillingAPIService(repo, blobs, creditor, collections)
+	BillingAPIController := openapi.NewBillingAPIController(BillingAPIService)
+
+	ClaimAPIService := openapi.NewClaimAPIService(repo, rates, openapi.ClaimPolicy{ReviewThreshold: float32(*reviewThreshold)})
+	ClaimAPIController := openapi.NewClaimAPIController(ClaimAPIService)
+
+	ContractAPIService := openapi.NewContractAPIService(repo, rates, policy, contracts)
 	ContractAPIController := openapi.NewContractAPIController(ContractAPIService)
 
-	CustomerAPIService := openapi.NewCustomerAPIService()
+	CustomerAPIService := openapi.NewCustomerAPIService(repo, banks)
 	CustomerAPIController := openapi.NewCustomerAPIController(CustomerAPIService)
 
-	EmployeeAPIService := openapi.NewEmployeeAPIService()
+	DocumentAPIService := openapi.NewDocumentAPIService(repo, blobs, *maxUploadSize)
+	DocumentAPIController := openapi.NewDocumentAPIController(DocumentAPIService, openapi.WithDocumentAPIMaxUploadSize(*maxUploadSize))
+
+	DunningAPIService := openapi.NewDunningAPIService(repo)
+	DunningAPIController := openapi.NewDunningAPIController(DunningAPIService)
+
+	EmployeeAPIService := openapi.NewEmployeeAPIService(repo, mailer, *portalURL)
 	EmployeeAPIController := openapi.NewEmployeeAPIController(EmployeeAPIService)
 
-	router := openapi.NewRouter(ContractAPIController, CustomerAPIController, EmployeeAPIController)
+	MandateAPIService := openapi.NewMandateAPIService(repo, creditor.Id, collections)
+	MandateAPIController := openapi.NewMandateAPIController(MandateAPIService)
+
+	PaymentAPIService := openapi.NewPaymentAPIService(repo, creditor, collections)
+	PaymentAPIController := openapi.NewPaymentAPIController(PaymentAPIService)
+
+	scheduler := openapi.NewContractScheduler(repo, rates, contracts, openapi.WithContractSchedulerInterval(*renewalInterval))
+	go scheduler.Run(context.Background())
+
+	dunning := openapi.NewDunningScheduler(repo, dunningPolicy, mailer, contracts, openapi.WithDunningSchedulerInterval(*dunningInterval))
+	go dunning.Run(context.Background())
+
+	router := openapi.NewRouter(authenticator, authorizer, AccountAPIController, BillingAPIController, ClaimAPIController, ContractAPIController, CustomerAPIController, DocumentAPIController, DunningAPIController, EmployeeAPIController, MandateAPIController, PaymentAPIController)
 
 	log.Fatal(http.ListenAndServe(":8080", router))
 }
+
+// newStore opens the persistence backend selected on the command line
+func newStore(kind string, path string) (openapi.Store, error) {
+	switch kind {
+	case "memory":
+		return openapi.NewMemoryStore(), nil
+	case "bolt":
+		log.Printf("Using bolt store at %s", path)
+		return openapi.NewBoltStore(path)
+	default:
+		return nil, fmt.Errorf("unknown store %q", kind)
+	}
+}
+
+// newBankDirectory loads the banks from the Bankleitzahlendatei at path, or the built-in excerpt if path is empty
+func newBankDirectory(path string) (*openapi.BankDirectory, error) {
+	if path == "" {
+		return openapi.DefaultBankDirectory()
+	}
+	banks, err := openapi.LoadBankDirectory(path)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Loaded %d banks from %s", banks.Len(), path)
+
+	return banks, nil
+}
+
+// authConfig holds the command line flags configuring authentication
+type authConfig struct {
+	secretPath      string
+	jwksPath        string
+	issuer          string
+	audience        string
+	apiKeysPath     string
+	anonymousRoutes []string
+}
+
+// newSessionKey returns the key of the shared secret at path, which signs the session tokens issued on login and
+// verifies other HMAC signed bearer tokens. Without a secret a random one is generated, so session tokens become
+// invalid when the server restarts.
+func newSessionKey(path string) (openapi.JSONWebKey, error) {
+	secret := make([]byte, 32)
+	if path == "" {
+		if _, err := rand.Read(secret); err != nil {
+			return openapi.JSONWebKey{}, err
+		}
+		log.Printf("No -jwt-secret set, session tokens are signed with a random secret and become invalid on restart")
+	} else {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return openapi.JSONWebKey{}, err
+		}
+		secret = bytes.TrimSpace(data)
+	}
+
+	key, err := openapi.NewSymmetricKey(secret)
+	if err != nil {
+		return openapi.JSONWebKey{}, fmt.Errorf("jwt secret %s: %w", path, err)
+	}
+
+	return key, nil
+}
+
+// newAuthenticator creates the authenticator of the session key and the configured token keys and API keys
+func newAuthenticator(config authConfig, sessionKey openapi.JSONWebKey) (*openapi.Authenticator, error) {
+	opts := []openapi.AuthenticatorOption{
+		openapi.WithTokenIssuer(config.issuer),
+		openapi.WithTokenAudience(config.audience),
+		openapi.WithTokenKeys(sessionKey),
+	}
+
+	if config.jwksPath != "" {
+		set, err := openapi.LoadJSONWebKeySet(config.jwksPath)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Loaded %d token keys from %s", len(set.Keys), config.jwksPath)
+		opts = append(opts, openapi.WithTokenKeys(set.Keys...))
+	}
+	if config.apiKeysPath != "" {
+		keys, err := openapi.LoadAPIKeys(config.apiKeysPath)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Loaded %d API keys from %s", len(keys), config.apiKeysPath)
+		opts = append(opts, openapi.WithAPIKeys(keys...))
+	}
+	opts = append(opts, openapi.WithAnonymousRoutes(config.anonymousRoutes...))
+
+	return openapi.NewAuthenticator(opts...), nil
+}
+
+// mailConfig holds the command line flags configuring how emails are sent
+type mailConfig struct {
+	smtpAddr string
+	from     string
+	username string
+}
+
+// newMailer creates a mailer sending through the configured SMTP server, or logging the emails if there is none
+func newMailer(config mailConfig) (openapi.Mailer, error) {
+	if config.smtpAddr == "" {
+		log.Printf("No -smtp server set, emails are only logged")
+		return openapi.LogMailer{}, nil
+	}
+
+	mailer, err := openapi.NewSMTPMailer(config.smtpAddr, config.from, config.username, os.Getenv("SMTP_PASSWORD"))
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Sending emails through %s", config.smtpAddr)
+
+	return mailer, nil
+}
+
+// newAccessPolicy loads the access policy from path, or the built-in policy if path is empty
+func newAccessPolicy(path string) (openapi.AccessPolicy, error) {
+	if path == "" {
+		return openapi.DefaultAccessPolicy()
+	}
+	policy, err := openapi.LoadAccessPolicy(path)
+	if err != nil {
+		return openapi.AccessPolicy{}, err
+	}
+	log.Printf("Loaded access policy for %d routes from %s", len(policy.Routes), path)
+
+	return policy, nil
+}
+
+// newDunningPolicy loads the dunning policy from path, or the built-in policy if path is empty
+func newDunningPolicy(path string) (openapi.DunningPolicy, error) {
+	if path == "" {
+		return openapi.DefaultDunningPolicy()
+	}
+	policy, err := openapi.LoadDunningPolicy(path)
+	if err != nil {
+		return openapi.DunningPolicy{}, err
+	}
+	log.Printf("Loaded dunning policy with %d stages from %s", len(policy.Stages), path)
+
+	return policy, nil
+}
+
+// newRateEngine loads the tariffs from dir, or the built-in tariff if dir is empty, and reloads them on SIGHUP
+func newRateEngine(dir string) (*openapi.RateEngine, error) {
+	source := openapi.TariffSource(openapi.DefaultTariffs)
+	if dir != "" {
+		source = openapi.TariffDirectory(dir)
+	}
+	rates, err := openapi.NewRateEngine(source)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Loaded tariffs %s", strings.Join(rates.Versions(), ", "))
+
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	go func() {
+		for range hangup {
+			if err := rates.Reload(); err != nil {
+				log.Printf("Reloading tariffs failed, keeping the previous ones: %v", err)
+				continue
+			}
+			log.Printf("Reloaded tariffs %s", strings.Join(rates.Versions(), ", "))
+		}
+	}()
+
+	return rates, nil
+}